@@ -0,0 +1,255 @@
+package access
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/netip"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/brenfwd/gocraft/data"
+	"github.com/google/uuid"
+)
+
+type WhitelistEntry struct {
+	UUID uuid.UUID `json:"uuid"`
+	Name string    `json:"name"`
+}
+
+type OpEntry struct {
+	UUID uuid.UUID `json:"uuid"`
+	Name string    `json:"name"`
+	// Permission level from 1 to 4, see command.Command.Level
+	Level               int  `json:"level"`
+	BypassesPlayerLimit bool `json:"bypassesPlayerLimit"`
+}
+
+// Details shared by player and IP bans.
+type Ban struct {
+	Created time.Time
+	// Who issued the ban, e.g. "Server" for the console
+	Source string
+	// Zero for bans that don't expire
+	Expires time.Time
+	Reason  string
+}
+
+func (b Ban) Expired(now time.Time) bool {
+	return !b.Expires.IsZero() && !now.Before(b.Expires)
+}
+
+type BanEntry struct {
+	UUID uuid.UUID
+	Name string
+	Ban
+}
+
+type IPBanEntry struct {
+	IP netip.Addr
+	Ban
+}
+
+// Vanilla's date format in the ban lists, with "forever" for bans that don't
+// expire.
+const (
+	banTimeLayout = "2006-01-02 15:04:05 -0700"
+	banForever    = "forever"
+)
+
+type banJSON struct {
+	Created string `json:"created"`
+	Source  string `json:"source"`
+	Expires string `json:"expires"`
+	Reason  string `json:"reason"`
+}
+
+func (b Ban) toJSON() banJSON {
+	expires := banForever
+	if !b.Expires.IsZero() {
+		expires = b.Expires.Format(banTimeLayout)
+	}
+	return banJSON{Created: b.Created.Format(banTimeLayout), Source: b.Source, Expires: expires, Reason: b.Reason}
+}
+
+func (j banJSON) ban() (Ban, error) {
+	b := Ban{Source: j.Source, Reason: j.Reason}
+	var err error
+	if b.Created, err = time.Parse(banTimeLayout, j.Created); err != nil {
+		return Ban{}, fmt.Errorf("ban created: %w", err)
+	}
+	if j.Expires != banForever && j.Expires != "" {
+		if b.Expires, err = time.Parse(banTimeLayout, j.Expires); err != nil {
+			return Ban{}, fmt.Errorf("ban expires: %w", err)
+		}
+	}
+	return b, nil
+}
+
+type banEntryJSON struct {
+	UUID uuid.UUID `json:"uuid"`
+	Name string    `json:"name"`
+	banJSON
+}
+
+func (e BanEntry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(banEntryJSON{UUID: e.UUID, Name: e.Name, banJSON: e.Ban.toJSON()})
+}
+
+func (e *BanEntry) UnmarshalJSON(raw []byte) error {
+	var j banEntryJSON
+	if err := json.Unmarshal(raw, &j); err != nil {
+		return err
+	}
+	ban, err := j.ban()
+	if err != nil {
+		return err
+	}
+	*e = BanEntry{UUID: j.UUID, Name: j.Name, Ban: ban}
+	return nil
+}
+
+type ipBanEntryJSON struct {
+	IP netip.Addr `json:"ip"`
+	banJSON
+}
+
+func (e IPBanEntry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ipBanEntryJSON{IP: e.IP, banJSON: e.Ban.toJSON()})
+}
+
+func (e *IPBanEntry) UnmarshalJSON(raw []byte) error {
+	var j ipBanEntryJSON
+	if err := json.Unmarshal(raw, &j); err != nil {
+		return err
+	}
+	ban, err := j.ban()
+	if err != nil {
+		return err
+	}
+	*e = IPBanEntry{IP: j.IP.Unmap(), Ban: ban}
+	return nil
+}
+
+// The whitelist, operator and ban lists, stored in the same files as vanilla
+// so they can be copied over.
+type Lists struct {
+	Whitelist     *List[uuid.UUID, WhitelistEntry]
+	Ops           *List[uuid.UUID, OpEntry]
+	BannedPlayers *List[uuid.UUID, BanEntry]
+	BannedIPs     *List[netip.Addr, IPBanEntry]
+
+	whitelistEnabled atomic.Bool
+}
+
+// Loads the lists from dir, creating the files that don't exist yet.
+func Open(dir string) (*Lists, error) {
+	l := &Lists{
+		Whitelist:     newList(filepath.Join(dir, "whitelist.json"), func(e WhitelistEntry) uuid.UUID { return e.UUID }),
+		Ops:           newList(filepath.Join(dir, "ops.json"), func(e OpEntry) uuid.UUID { return e.UUID }),
+		BannedPlayers: newList(filepath.Join(dir, "banned-players.json"), func(e BanEntry) uuid.UUID { return e.UUID }),
+		BannedIPs:     newList(filepath.Join(dir, "banned-ips.json"), func(e IPBanEntry) netip.Addr { return e.IP }),
+	}
+	if err := l.Reload(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *Lists) Reload() error {
+	for _, load := range []func() error{l.Whitelist.Load, l.Ops.Load, l.BannedPlayers.Load, l.BannedIPs.Load} {
+		if err := load(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reloads the files edited by hand (or by another tool) every interval, until
+// stop is closed.
+func (l *Lists) Watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			l.Whitelist.reloadIfChanged()
+			l.Ops.reloadIfChanged()
+			l.BannedPlayers.reloadIfChanged()
+			l.BannedIPs.reloadIfChanged()
+		}
+	}
+}
+
+// Whether only whitelisted players and operators may join. Off by default.
+func (l *Lists) WhitelistEnabled() bool {
+	return l.whitelistEnabled.Load()
+}
+
+func (l *Lists) SetWhitelistEnabled(enabled bool) {
+	l.whitelistEnabled.Store(enabled)
+}
+
+// The player's operator level, 0 if they aren't one.
+func (l *Lists) OpLevel(id uuid.UUID) int {
+	op, ok := l.Ops.Get(id)
+	if !ok {
+		return 0
+	}
+	return op.Level
+}
+
+// The player's ban, unless there is none or it expired. Expired bans are
+// removed.
+func (l *Lists) PlayerBan(id uuid.UUID) (BanEntry, bool) {
+	ban, ok := l.BannedPlayers.Get(id)
+	if ok && ban.Expired(time.Now()) {
+		if _, err := l.BannedPlayers.Remove(id); err != nil {
+			log.Println("Error removing expired ban:", err)
+		}
+		return BanEntry{}, false
+	}
+	return ban, ok
+}
+
+// The address's ban, unless there is none or it expired. Expired bans are
+// removed.
+func (l *Lists) IPBan(addr netip.Addr) (IPBanEntry, bool) {
+	ban, ok := l.BannedIPs.Get(addr.Unmap())
+	if ok && ban.Expired(time.Now()) {
+		if _, err := l.BannedIPs.Remove(addr.Unmap()); err != nil {
+			log.Println("Error removing expired ban:", err)
+		}
+		return IPBanEntry{}, false
+	}
+	return ban, ok
+}
+
+// Decides whether a player may join, returning the message to disconnect them
+// with if not. Checked in the same order as vanilla.
+func (l *Lists) CheckLogin(id uuid.UUID, addr netip.Addr) *data.Chat {
+	if ban, ok := l.PlayerBan(id); ok {
+		return ban.kickMessage("You are banned from this server.")
+	}
+	if l.WhitelistEnabled() && !l.Whitelist.Contains(id) && !l.Ops.Contains(id) {
+		return data.MakeChat().SetText("You are not white-listed on this server!")
+	}
+	if ban, ok := l.IPBan(addr); ok {
+		return ban.kickMessage("Your IP address is banned from this server.")
+	}
+	return nil
+}
+
+func (b Ban) kickMessage(prefix string) *data.Chat {
+	text := prefix
+	if b.Reason != "" {
+		text += "\nReason: " + b.Reason
+	}
+	if !b.Expires.IsZero() {
+		text += "\nYour ban will be removed on " + b.Expires.Format(banTimeLayout)
+	}
+	return data.MakeChat().SetText(text)
+}
@@ -0,0 +1,342 @@
+package access
+
+import (
+	"encoding/json"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	notch = uuid.MustParse("069a79f4-44e9-4726-a5be-fca90e38aaf5")
+	jeb   = uuid.MustParse("853c80ef-3c37-49fd-aa49-938b674adae6")
+)
+
+// The lists as vanilla writes them.
+var vanillaFiles = map[string]string{
+	"whitelist.json": `[
+  {
+    "uuid": "069a79f4-44e9-4726-a5be-fca90e38aaf5",
+    "name": "Notch"
+  }
+]
+`,
+	"ops.json": `[
+  {
+    "uuid": "853c80ef-3c37-49fd-aa49-938b674adae6",
+    "name": "jeb_",
+    "level": 4,
+    "bypassesPlayerLimit": false
+  }
+]
+`,
+	"banned-players.json": `[
+  {
+    "uuid": "069a79f4-44e9-4726-a5be-fca90e38aaf5",
+    "name": "Notch",
+    "created": "2024-06-13 10:20:30 +0000",
+    "source": "Server",
+    "expires": "forever",
+    "reason": "Banned by an operator."
+  }
+]
+`,
+	"banned-ips.json": `[
+  {
+    "ip": "192.0.2.1",
+    "created": "2024-06-13 10:20:30 +0200",
+    "source": "jeb_",
+    "expires": "2124-06-13 10:20:30 +0200",
+    "reason": "Griefing"
+  }
+]
+`,
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(raw)
+}
+
+func TestOpenCreatesFiles(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := Open(dir); err != nil {
+		t.Fatal(err)
+	}
+	for name := range vanillaFiles {
+		if got := readFile(t, filepath.Join(dir, name)); got != "[]\n" {
+			t.Errorf("%s: got %q, want an empty list", name, got)
+		}
+	}
+}
+
+func TestVanillaFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, vanillaFiles)
+	l, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !l.Whitelist.Contains(notch) {
+		t.Error("Notch isn't whitelisted")
+	}
+	if level := l.OpLevel(jeb); level != 4 {
+		t.Errorf("got op level %d, want 4", level)
+	}
+	ban, ok := l.PlayerBan(notch)
+	want := Ban{
+		Created: time.Date(2024, 6, 13, 10, 20, 30, 0, time.UTC),
+		Source:  "Server",
+		Reason:  "Banned by an operator.",
+	}
+	if !ok || !ban.Created.Equal(want.Created) || ban.Source != want.Source || !ban.Expires.IsZero() || ban.Reason != want.Reason {
+		t.Errorf("got ban %+v, want %+v", ban, want)
+	}
+	ipBan, ok := l.IPBan(netip.MustParseAddr("::ffff:192.0.2.1"))
+	if !ok || ipBan.Expires.Year() != 2124 || ipBan.Reason != "Griefing" {
+		t.Errorf("got IP ban %+v", ipBan)
+	}
+
+	// Saving writes the files back unchanged
+	if err := l.Whitelist.Add(WhitelistEntry{UUID: notch, Name: "Notch"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Ops.Add(OpEntry{UUID: jeb, Name: "jeb_", Level: 4}); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.BannedPlayers.Add(l.BannedPlayers.Entries()[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.BannedIPs.Add(l.BannedIPs.Entries()[0]); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range vanillaFiles {
+		if got := readFile(t, filepath.Join(dir, name)); got != want {
+			t.Errorf("%s: got\n%s\nwant\n%s", name, got, want)
+		}
+	}
+}
+
+func TestListRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	l, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	created := time.Date(2024, 6, 13, 10, 20, 30, 0, time.FixedZone("", -5*60*60))
+	bans := []BanEntry{
+		{UUID: notch, Name: "Notch", Ban: Ban{Created: created, Source: "Server", Reason: "Spam"}},
+		{UUID: jeb, Name: "jeb_", Ban: Ban{Created: created, Source: "Notch", Expires: created.Add(48 * time.Hour)}},
+	}
+	for _, ban := range bans {
+		if err := l.BannedPlayers.Add(ban); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if removed, err := l.BannedPlayers.Remove(notch); !removed || err != nil {
+		t.Fatalf("Remove = %v, %v", removed, err)
+	}
+	if removed, _ := l.BannedPlayers.Remove(notch); removed {
+		t.Error("removed Notch twice")
+	}
+
+	reopened, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := reopened.BannedPlayers.Entries()
+	if len(got) != 1 || got[0].UUID != jeb || !got[0].Created.Equal(created) || !got[0].Expires.Equal(bans[1].Expires) || got[0].Source != "Notch" {
+		t.Errorf("got %+v after reopening, want %+v", got, bans[1:])
+	}
+	if !reopened.BannedPlayers.Contains(jeb) || reopened.BannedPlayers.Contains(notch) {
+		t.Error("the index doesn't match the entries")
+	}
+}
+
+func TestBadFiles(t *testing.T) {
+	tests := map[string]string{
+		"NotJSON":     `{`,
+		"BadDate":     `[{"uuid": "069a79f4-44e9-4726-a5be-fca90e38aaf5", "created": "yesterday", "expires": "forever"}]`,
+		"BadExpires":  `[{"uuid": "069a79f4-44e9-4726-a5be-fca90e38aaf5", "created": "2024-06-13 10:20:30 +0000", "expires": "never"}]`,
+		"NotAnObject": `["Notch"]`,
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, map[string]string{"banned-players.json": content})
+			if _, err := Open(dir); err == nil {
+				t.Error("opened a broken file")
+			}
+		})
+	}
+}
+
+func TestReloadIfChanged(t *testing.T) {
+	dir := t.TempDir()
+	l, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.Whitelist.reloadIfChanged()
+	if l.Whitelist.Contains(notch) {
+		t.Fatal("Notch is whitelisted before the file was edited")
+	}
+	writeFiles(t, dir, map[string]string{"whitelist.json": vanillaFiles["whitelist.json"]})
+	l.Whitelist.reloadIfChanged()
+	if !l.Whitelist.Contains(notch) {
+		t.Error("the edited file wasn't reloaded")
+	}
+}
+
+func TestCheckLogin(t *testing.T) {
+	addr := netip.MustParseAddr("192.0.2.1")
+	other := netip.MustParseAddr("192.0.2.2")
+	past := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name      string
+		whitelist bool
+		setup     func(l *Lists)
+		id        uuid.UUID
+		addr      netip.Addr
+		want      string
+	}{
+		{"Allowed", false, func(l *Lists) {}, notch, addr, ""},
+		{"Banned", false, func(l *Lists) {
+			l.BannedPlayers.Add(BanEntry{UUID: notch, Ban: Ban{Reason: "Spam"}})
+		}, notch, addr, "You are banned from this server.\nReason: Spam"},
+		{"BanExpires", false, func(l *Lists) {
+			l.BannedPlayers.Add(BanEntry{UUID: notch, Ban: Ban{Expires: time.Date(2124, 6, 13, 10, 20, 30, 0, time.UTC)}})
+		}, notch, addr, "You are banned from this server.\nYour ban will be removed on 2124-06-13 10:20:30 +0000"},
+		{"BanExpired", false, func(l *Lists) {
+			l.BannedPlayers.Add(BanEntry{UUID: notch, Ban: Ban{Expires: past}})
+		}, notch, addr, ""},
+		{"NotWhitelisted", true, func(l *Lists) {}, notch, addr, "You are not white-listed on this server!"},
+		{"Whitelisted", true, func(l *Lists) {
+			l.Whitelist.Add(WhitelistEntry{UUID: notch})
+		}, notch, addr, ""},
+		{"OpBypassesWhitelist", true, func(l *Lists) {
+			l.Ops.Add(OpEntry{UUID: notch, Level: 4})
+		}, notch, addr, ""},
+		{"IPBanned", false, func(l *Lists) {
+			l.BannedIPs.Add(IPBanEntry{IP: addr})
+		}, notch, addr, "Your IP address is banned from this server."},
+		{"IPBanExpired", false, func(l *Lists) {
+			l.BannedIPs.Add(IPBanEntry{IP: addr, Ban: Ban{Expires: past}})
+		}, notch, addr, ""},
+		{"OtherIPBanned", false, func(l *Lists) {
+			l.BannedIPs.Add(IPBanEntry{IP: other})
+		}, notch, addr, ""},
+
+		// Like vanilla: player bans, then the whitelist, then IP bans
+		{"BanBeforeWhitelist", true, func(l *Lists) {
+			l.BannedPlayers.Add(BanEntry{UUID: notch})
+		}, notch, addr, "You are banned from this server."},
+		{"BanBeforeIPBan", false, func(l *Lists) {
+			l.BannedPlayers.Add(BanEntry{UUID: notch})
+			l.BannedIPs.Add(IPBanEntry{IP: addr})
+		}, notch, addr, "You are banned from this server."},
+		{"WhitelistBeforeIPBan", true, func(l *Lists) {
+			l.BannedIPs.Add(IPBanEntry{IP: addr})
+		}, notch, addr, "You are not white-listed on this server!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := Open(t.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			l.SetWhitelistEnabled(tt.whitelist)
+			tt.setup(l)
+
+			var got string
+			if msg := l.CheckLogin(tt.id, tt.addr); msg != nil {
+				got = msg.PlainText()
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpiredBansAreRemoved(t *testing.T) {
+	l, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	past := Ban{Expires: time.Now().Add(-time.Second)}
+	addr := netip.MustParseAddr("192.0.2.1")
+	l.BannedPlayers.Add(BanEntry{UUID: notch, Ban: past})
+	l.BannedIPs.Add(IPBanEntry{IP: addr, Ban: past})
+	if msg := l.CheckLogin(notch, addr); msg != nil {
+		t.Fatalf("got %q for expired bans", msg.PlainText())
+	}
+	if got := l.BannedPlayers.Entries(); len(got) != 0 {
+		t.Errorf("kept expired player bans %+v", got)
+	}
+	if got := l.BannedIPs.Entries(); len(got) != 0 {
+		t.Errorf("kept expired IP bans %+v", got)
+	}
+}
+
+func TestBanExpired(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		expires time.Time
+		want    bool
+	}{
+		{time.Time{}, false},
+		{now.Add(time.Second), false},
+		{now, true},
+		{now.Add(-time.Second), true},
+	}
+	for _, tt := range tests {
+		if got := (Ban{Expires: tt.expires}).Expired(now); got != tt.want {
+			t.Errorf("Expired with expiry %v = %v, want %v", tt.expires, got, tt.want)
+		}
+	}
+}
+
+func TestBanJSON(t *testing.T) {
+	entry := IPBanEntry{IP: netip.MustParseAddr("2001:db8::1"), Ban: Ban{
+		Created: time.Date(2024, 6, 13, 10, 20, 30, 0, time.UTC),
+		Source:  "Server",
+	}}
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]string
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"ip":      "2001:db8::1",
+		"created": "2024-06-13 10:20:30 +0000",
+		"source":  "Server",
+		"expires": "forever",
+		"reason":  "",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
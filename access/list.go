@@ -0,0 +1,157 @@
+package access
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/brenfwd/gocraft/atomicfile"
+)
+
+// One of the JSON list files, with its entries indexed by key. Safe for
+// concurrent use; every change is saved right away.
+type List[K comparable, E any] struct {
+	path string
+	key  func(E) K
+
+	mu      sync.RWMutex
+	entries []E
+	index   map[K]int
+	// Of the file as last loaded or saved, to notice edits by others
+	modTime time.Time
+	size    int64
+}
+
+func newList[K comparable, E any](path string, key func(E) K) *List[K, E] {
+	return &List[K, E]{path: path, key: key, index: make(map[K]int)}
+}
+
+func (l *List[K, E]) Get(key K) (E, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	i, ok := l.index[key]
+	if !ok {
+		var zero E
+		return zero, false
+	}
+	return l.entries[i], true
+}
+
+func (l *List[K, E]) Contains(key K) bool {
+	_, ok := l.Get(key)
+	return ok
+}
+
+// A snapshot of the entries, in file order.
+func (l *List[K, E]) Entries() []E {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return append([]E(nil), l.entries...)
+}
+
+// Adds an entry, replacing the one with the same key.
+func (l *List[K, E]) Add(entry E) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if i, ok := l.index[l.key(entry)]; ok {
+		l.entries[i] = entry
+	} else {
+		l.index[l.key(entry)] = len(l.entries)
+		l.entries = append(l.entries, entry)
+	}
+	return l.save()
+}
+
+// Removes the entry with the given key, reporting whether there was one.
+func (l *List[K, E]) Remove(key K) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	i, ok := l.index[key]
+	if !ok {
+		return false, nil
+	}
+	l.entries = append(l.entries[:i], l.entries[i+1:]...)
+	l.reindex()
+	return true, l.save()
+}
+
+// Reads the file again. A missing file is created empty, like vanilla does.
+func (l *List[K, E]) Load() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	raw, err := os.ReadFile(l.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		l.entries = nil
+		l.reindex()
+		return l.save()
+	}
+	if err != nil {
+		return err
+	}
+	var entries []E
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return fmt.Errorf("%s: %w", l.path, err)
+	}
+	l.entries = entries
+	l.reindex()
+	return l.stat()
+}
+
+// Loads the file again if it changed since it was last loaded or saved.
+func (l *List[K, E]) reloadIfChanged() {
+	info, err := os.Stat(l.path)
+	if err != nil {
+		return
+	}
+	l.mu.RLock()
+	changed := !info.ModTime().Equal(l.modTime) || info.Size() != l.size
+	l.mu.RUnlock()
+	if !changed {
+		return
+	}
+	if err := l.Load(); err != nil {
+		log.Printf("Error reloading %s: %v", l.path, err)
+		return
+	}
+	log.Printf("Reloaded %s", l.path)
+}
+
+// Must be called with mu held.
+func (l *List[K, E]) reindex() {
+	clear(l.index)
+	for i, entry := range l.entries {
+		l.index[l.key(entry)] = i
+	}
+}
+
+// Must be called with mu held.
+func (l *List[K, E]) save() error {
+	entries := l.entries
+	if entries == nil {
+		entries = []E{}
+	}
+	raw, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := atomicfile.WriteFile(l.path, append(raw, '\n'), 0o644); err != nil {
+		return err
+	}
+	return l.stat()
+}
+
+// Must be called with mu held.
+func (l *List[K, E]) stat() error {
+	info, err := os.Stat(l.path)
+	if err != nil {
+		return err
+	}
+	l.modTime, l.size = info.ModTime(), info.Size()
+	return nil
+}
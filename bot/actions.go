@@ -0,0 +1,89 @@
+package bot
+
+import (
+	"time"
+
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network/messages/serverbound"
+)
+
+// Block faces, in the order used by Player Action and Use Item On.
+const (
+	FaceBottom byte = iota
+	FaceTop
+	FaceNorth
+	FaceSouth
+	FaceWest
+	FaceEast
+)
+
+func (b *Bot) nextSequence() data.VarInt {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sequence++
+	return data.VarInt(b.sequence)
+}
+
+// Moves to the given position. The server may reject the move and teleport the
+// bot back, which updates Position once it has been received.
+func (b *Bot) Move(pos data.Vec3) error {
+	b.mu.Lock()
+	b.position = pos
+	b.mu.Unlock()
+	return b.send(&serverbound.PlayServerboundSetPlayerPosition{X: pos.X, FeetY: pos.Y, Z: pos.Z, OnGround: true})
+}
+
+func (b *Bot) Look(yaw, pitch float32) error {
+	b.mu.Lock()
+	b.yaw, b.pitch = yaw, pitch
+	b.mu.Unlock()
+	return b.send(&serverbound.PlayServerboundSetPlayerRotation{Yaw: yaw, Pitch: pitch, OnGround: true})
+}
+
+func (b *Bot) Chat(message string) error {
+	return b.send(&serverbound.PlayServerboundChatMessage{
+		Message:   message,
+		Timestamp: time.Now().UnixMilli(),
+	})
+}
+
+// Runs a command, without the leading slash.
+func (b *Bot) Command(command string) error {
+	return b.send(&serverbound.PlayServerboundChatCommand{
+		Command:   command,
+		Timestamp: time.Now().UnixMilli(),
+	})
+}
+
+// Breaks the block at pos: instantly in creative mode, otherwise the server
+// decides whether enough time passed since starting.
+func (b *Bot) Dig(pos data.Position, face byte) error {
+	err := b.send(&serverbound.PlayServerboundPlayerAction{
+		Status:   serverbound.PlayerActionStartedDigging,
+		Location: pos,
+		Face:     face,
+		Sequence: b.nextSequence(),
+	})
+	if err != nil {
+		return err
+	}
+	return b.send(&serverbound.PlayServerboundPlayerAction{
+		Status:   serverbound.PlayerActionFinishedDigging,
+		Location: pos,
+		Face:     face,
+		Sequence: b.nextSequence(),
+	})
+}
+
+// Places the held item against the given face of the block at pos.
+func (b *Bot) Place(pos data.Position, face byte) error {
+	return b.send(&serverbound.PlayServerboundUseItemOn{
+		Hand:     0,
+		Location: pos,
+		Face:     data.VarInt(face),
+		CursorX:  0.5,
+		CursorY:  0.5,
+		CursorZ:  0.5,
+		Sequence: b.nextSequence(),
+	})
+}
@@ -0,0 +1,373 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/network/messages/serverbound"
+	"github.com/brenfwd/gocraft/world"
+	"github.com/google/uuid"
+)
+
+type Config struct {
+	Address  string
+	Username string
+	// Defaults to the offline-mode UUID of Username
+	UUID uuid.UUID
+	// Defaults to the latest supported version
+	Protocol constants.ProtocolVersion
+	// If set, the bot authenticates with the session server before enabling
+	// encryption (online mode). Otherwise it logs in like an offline client.
+	AccessToken string
+	// Answers to login plugin requests by channel, e.g. to play a mod's
+	// handshake. Returning nil leaves the request unanswered. Like vanilla,
+	// requests on other channels aren't understood.
+	LoginPlugins map[string]func(data []byte) []byte
+	// How long Join may take in total. Defaults to 10 seconds.
+	Timeout time.Duration
+}
+
+// Returned when the server kicks the bot.
+type DisconnectError struct {
+	State  constants.ClientState
+	Reason *data.Chat
+}
+
+func (e *DisconnectError) Error() string {
+	return fmt.Sprintf("disconnected in state %v: %s", e.State, e.Reason.PlainText())
+}
+
+// A headless client that joins a server and can be scripted, e.g. by
+// integration tests. Keep alives and teleports are answered automatically.
+type Bot struct {
+	Username string
+	UUID     uuid.UUID
+	Protocol constants.ProtocolVersion
+
+	conn  *network.ClientConnection
+	state constants.ClientState
+
+	mu               sync.Mutex
+	entityID         int32
+	position         data.Vec3
+	yaw, pitch       float32
+	chunks           map[world.ChunkPos]bool
+	players          map[uuid.UUID]string
+	blocks           map[data.Position]int32
+	sequence         int32
+	disconnectReason *data.Chat
+	err              error
+
+	messages chan string
+	done     chan struct{}
+}
+
+// Connects to the server, logs in and waits until the bot has been placed in
+// the world.
+func Join(config Config) (*Bot, error) {
+	if config.Protocol == 0 {
+		config.Protocol = constants.ProtocolLatest
+	}
+	if config.UUID == uuid.Nil {
+		config.UUID = data.OfflineUUID(config.Username)
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	conn, err := network.Dial(config.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Bot{
+		Username: config.Username,
+		UUID:     config.UUID,
+		Protocol: config.Protocol,
+		conn:     conn,
+		state:    constants.ClientStateHandshaking,
+		chunks:   make(map[world.ChunkPos]bool),
+		players:  make(map[uuid.UUID]string),
+		blocks:   make(map[data.Position]int32),
+		messages: make(chan string, 256),
+		done:     make(chan struct{}),
+	}
+
+	timer := time.AfterFunc(config.Timeout, func() { conn.Close() })
+	err = b.login(config)
+	if timer.Stop() && err == nil {
+		go b.readLoop()
+		return b, nil
+	}
+	conn.Close()
+	if err == nil {
+		err = errors.New("timed out joining")
+	}
+	return nil, fmt.Errorf("bot %s: %w", config.Username, err)
+}
+
+// The version messages are encoded in. Bots can claim to be on an unsupported
+// version (e.g. to test outdated client kicks), they then get as far as the
+// oldest supported version's packets allow.
+func (b *Bot) codecProtocol() constants.ProtocolVersion {
+	if !b.Protocol.Supported() {
+		return constants.ProtocolOldest
+	}
+	return b.Protocol
+}
+
+func (b *Bot) send(msg any) error {
+	packet, err := messages.EncodeServerbound(b.codecProtocol(), msg)
+	if err != nil {
+		return err
+	}
+	return b.conn.WritePacket(&packet)
+}
+
+func (b *Bot) receive() (any, error) {
+	for {
+		packet, err := b.conn.ReadPacket()
+		if err != nil {
+			return nil, err
+		}
+		msg, err := messages.DecodeClientbound(b.codecProtocol(), b.state, &packet)
+		if err != nil {
+			// Packets the messages package can't decode are skipped
+			continue
+		}
+		return msg, nil
+	}
+}
+
+func (b *Bot) readLoop() {
+	defer close(b.done)
+	defer close(b.messages)
+	for {
+		msg, err := b.receive()
+		if err == nil {
+			if b.state == constants.ClientStateConfiguration {
+				err = b.handleConfiguration(msg)
+			} else {
+				err = b.handlePlay(msg)
+			}
+		}
+		if err != nil {
+			b.mu.Lock()
+			if b.err == nil {
+				b.err = err
+			}
+			b.mu.Unlock()
+			b.conn.Close()
+			return
+		}
+	}
+}
+
+func (b *Bot) handlePlay(msg any) error {
+	switch m := msg.(type) {
+	case *clientbound.PlayClientboundLogin:
+		// Sent again after reconfiguration, e.g. on another server
+		b.mu.Lock()
+		b.entityID = m.EntityID
+		clear(b.chunks)
+		clear(b.players)
+		clear(b.blocks)
+		b.mu.Unlock()
+	case *clientbound.PlayClientboundPlayerInfoUpdate:
+		if m.Actions&clientbound.PlayerInfoAddPlayer == 0 {
+			return nil
+		}
+		b.mu.Lock()
+		for _, player := range m.Players {
+			b.players[player.UUID] = player.Name
+		}
+		b.mu.Unlock()
+	case *clientbound.PlayClientboundPlayerInfoRemove:
+		b.mu.Lock()
+		for _, id := range m.Players {
+			delete(b.players, id)
+		}
+		b.mu.Unlock()
+	case *clientbound.PlayClientboundStartConfiguration:
+		b.state = constants.ClientStateConfiguration
+		return b.send(&serverbound.PlayServerboundConfigurationAcknowledged{})
+	case *clientbound.PlayClientboundKeepAlive:
+		return b.send(&serverbound.PlayServerboundKeepAlive{KeepAliveID: m.KeepAliveID})
+	case *clientbound.PlayClientboundSynchronizePlayerPosition:
+		return b.synchronizePosition(m)
+	case *clientbound.PlayClientboundChunkDataAndUpdateLight:
+		b.mu.Lock()
+		b.chunks[world.ChunkPos{X: m.ChunkX, Z: m.ChunkZ}] = true
+		b.mu.Unlock()
+	case *clientbound.PlayClientboundUnloadChunk:
+		b.mu.Lock()
+		delete(b.chunks, world.ChunkPos{X: m.ChunkX, Z: m.ChunkZ})
+		b.mu.Unlock()
+	case *clientbound.PlayClientboundBlockUpdate:
+		b.mu.Lock()
+		b.blocks[m.Location] = int32(m.BlockID)
+		b.mu.Unlock()
+	case *clientbound.PlayClientboundSystemChatMessage:
+		if m.Overlay {
+			return nil
+		}
+		text := m.ContentJSON.PlainText()
+		if m.Content != nil {
+			text = data.ChatFromNBT(m.Content).PlainText()
+		}
+		select {
+		case b.messages <- text:
+		default:
+			log.Printf("Bot %s dropped chat message %q, nobody is reading", b.Username, text)
+		}
+	case *clientbound.PlayClientboundDisconnect:
+		reason := &m.ReasonJSON
+		if m.Reason != nil {
+			reason = data.ChatFromNBT(m.Reason)
+		}
+		b.mu.Lock()
+		b.disconnectReason = reason
+		b.mu.Unlock()
+		return &DisconnectError{State: constants.ClientStatePlay, Reason: reason}
+	}
+	return nil
+}
+
+func (b *Bot) synchronizePosition(m *clientbound.PlayClientboundSynchronizePlayerPosition) error {
+	b.mu.Lock()
+	relative := func(flag byte, cur, v float64) float64 {
+		if m.Flags&flag != 0 {
+			return cur + v
+		}
+		return v
+	}
+	b.position = data.Vec3{
+		X: relative(clientbound.SyncPositionRelativeX, b.position.X, m.X),
+		Y: relative(clientbound.SyncPositionRelativeY, b.position.Y, m.Y),
+		Z: relative(clientbound.SyncPositionRelativeZ, b.position.Z, m.Z),
+	}
+	b.yaw = float32(relative(clientbound.SyncPositionRelativeYaw, float64(b.yaw), float64(m.Yaw)))
+	b.pitch = float32(relative(clientbound.SyncPositionRelativePitch, float64(b.pitch), float64(m.Pitch)))
+	b.mu.Unlock()
+
+	return b.send(&serverbound.PlayServerboundConfirmTeleportation{TeleportID: m.TeleportID})
+}
+
+func (b *Bot) Close() error {
+	b.mu.Lock()
+	if b.err == nil {
+		b.err = net.ErrClosed
+	}
+	b.mu.Unlock()
+	err := b.conn.Close()
+	<-b.done
+	return err
+}
+
+// Closed when the connection ends, see Err.
+func (b *Bot) Done() <-chan struct{} {
+	return b.done
+}
+
+// Why the connection ended, or nil while it's open.
+func (b *Bot) Err() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.err
+}
+
+// The reason sent by the server when it kicked the bot, if any.
+func (b *Bot) DisconnectReason() *data.Chat {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.disconnectReason
+}
+
+// Bytes received and sent on the wire so far.
+func (b *Bot) BytesRead() int64 {
+	return b.conn.BytesRead()
+}
+
+func (b *Bot) BytesWritten() int64 {
+	return b.conn.BytesWritten()
+}
+
+func (b *Bot) EntityID() int32 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.entityID
+}
+
+func (b *Bot) Position() data.Vec3 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.position
+}
+
+func (b *Bot) Rotation() (yaw, pitch float32) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.yaw, b.pitch
+}
+
+// Chunks the server has sent and not unloaded yet.
+func (b *Bot) LoadedChunks() []world.ChunkPos {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	chunks := make([]world.ChunkPos, 0, len(b.chunks))
+	for pos := range b.chunks {
+		chunks = append(chunks, pos)
+	}
+	return chunks
+}
+
+// The block state the server last sent for pos with Block Update, if it sent
+// one. Chunk contents aren't tracked.
+func (b *Bot) Block(pos data.Position) (int32, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	state, ok := b.blocks[pos]
+	return state, ok
+}
+
+// Names of the players in the player list, by UUID.
+func (b *Bot) PlayerList() map[uuid.UUID]string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return maps.Clone(b.players)
+}
+
+// Plain text of received chat messages. Closed when the connection ends.
+// Messages are dropped if the channel is full.
+func (b *Bot) Messages() <-chan string {
+	return b.messages
+}
+
+// Waits for a chat message matching the predicate, skipping all others.
+func (b *Bot) WaitForMessage(match func(string) bool, timeout time.Duration) (string, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case msg, ok := <-b.messages:
+			if !ok {
+				return "", fmt.Errorf("connection closed: %w", b.Err())
+			}
+			if match(msg) {
+				return msg, nil
+			}
+		case <-timer.C:
+			return "", errors.New("timed out waiting for message")
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package bot
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network/encryption"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/network/messages/serverbound"
+	"github.com/google/uuid"
+)
+
+// Session server endpoint used for online-mode logins. Tests can point it at a
+// fake.
+var SessionServerJoinURL = "https://sessionserver.mojang.com/session/minecraft/join"
+
+func (b *Bot) handshake(address string, next constants.ClientState) error {
+	host, portString, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return err
+	}
+	err = b.send(&serverbound.HandshakingServerboundHandshake{
+		ProtocolVersion: data.VarInt(b.Protocol),
+		ServerAddress:   host,
+		ServerPort:      uint16(port),
+		NextState:       data.VarInt(next),
+	})
+	b.state = next
+	return err
+}
+
+// Runs the login and configuration states, and waits in play until the server
+// has sent Login and the spawn position.
+func (b *Bot) login(config Config) error {
+	if err := b.handshake(config.Address, constants.ClientStateLogin); err != nil {
+		return err
+	}
+	if err := b.send(&serverbound.LoginServerboundLoginStart{Name: b.Username, PlayerUUID: b.UUID}); err != nil {
+		return err
+	}
+
+	spawned := false
+	for !spawned {
+		msg, err := b.receive()
+		if err != nil {
+			return err
+		}
+
+		switch m := msg.(type) {
+		// Login
+		case *clientbound.LoginClientboundDisconnect:
+			return &DisconnectError{State: b.state, Reason: &m.Reason}
+		case *clientbound.LoginClientboundEncryptionRequest:
+			err = b.encrypt(config, m)
+		case *clientbound.LoginClientboundLoginPluginRequest:
+			err = b.answerLoginPlugin(config, m)
+		case *clientbound.LoginClientboundSetCompression:
+			b.conn.SetCompression(int(m.Threshold))
+		case *clientbound.LoginClientboundSuccess:
+			b.UUID = m.UUID
+			b.Username = m.Username
+			err = b.send(&serverbound.LoginServerboundLoginAcknowledged{})
+			b.state = constants.ClientStateConfiguration
+
+		// Play
+		case *clientbound.PlayClientboundSynchronizePlayerPosition:
+			err = b.synchronizePosition(m)
+			spawned = true
+		default:
+			switch b.state {
+			case constants.ClientStateConfiguration:
+				err = b.handleConfiguration(msg)
+			case constants.ClientStatePlay:
+				err = b.handlePlay(msg)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Handles the configuration state, both while logging in and when the server
+// reconfigures the bot (e.g. a proxy moving it to another server).
+func (b *Bot) handleConfiguration(msg any) error {
+	switch m := msg.(type) {
+	case *clientbound.ConfigurationClientboundDisconnect:
+		reason := &m.ReasonJSON
+		if m.Reason != nil {
+			reason = data.ChatFromNBT(m.Reason)
+		}
+		b.mu.Lock()
+		b.disconnectReason = reason
+		b.mu.Unlock()
+		return &DisconnectError{State: b.state, Reason: reason}
+	case *clientbound.ConfigurationClientboundKeepAlive:
+		return b.send(&serverbound.ConfigurationServerboundKeepAlive{KeepAliveID: m.KeepAliveID})
+	case *clientbound.ConfigurationClientboundPing:
+		return b.send(&serverbound.ConfigurationServerboundPong{ID: m.ID})
+	case *clientbound.ConfigurationClientboundKnownPacks:
+		// Claim to know no packs, so the server sends everything
+		return b.send(&serverbound.ConfigurationServerboundKnownPacks{KnownPacks: []serverbound.ConfigurationServerboundKnownPacks_KnownPack{}})
+	case *clientbound.ConfigurationClientboundFinishConfiguration:
+		b.state = constants.ClientStatePlay
+		return b.send(&serverbound.ConfigurationServerboundAcknowledgeFinishConfiguration{})
+	}
+	return nil
+}
+
+func (b *Bot) encrypt(config Config, m *clientbound.LoginClientboundEncryptionRequest) error {
+	sharedSecret := make([]byte, 16)
+	if _, err := rand.Read(sharedSecret); err != nil {
+		return err
+	}
+
+	if config.AccessToken != "" {
+		serverHash := encryption.ServerHash(m.ServerID, sharedSecret, m.PublicKey)
+		if err := joinSession(config.AccessToken, b.UUID, serverHash); err != nil {
+			return err
+		}
+	}
+
+	encSharedSecret := bytes.Clone(sharedSecret)
+	if err := encryption.EncryptWithPublicKey(m.PublicKey, &encSharedSecret); err != nil {
+		return err
+	}
+	encVerifyToken := bytes.Clone(m.VerifyToken)
+	if err := encryption.EncryptWithPublicKey(m.PublicKey, &encVerifyToken); err != nil {
+		return err
+	}
+
+	err := b.send(&serverbound.LoginServerboundEncryptionResponse{
+		SharedSecret: encSharedSecret,
+		VerifyToken:  encVerifyToken,
+	})
+	if err != nil {
+		return err
+	}
+	return b.conn.EnableEncryption(sharedSecret)
+}
+
+// Tells the session server the player is joining, so the server can verify it
+// with hasJoined.
+func joinSession(accessToken string, profile uuid.UUID, serverHash string) error {
+	body, err := json.Marshal(map[string]string{
+		"accessToken":     accessToken,
+		"selectedProfile": strings.ReplaceAll(profile.String(), "-", ""),
+		"serverId":        serverHash,
+	})
+	if err != nil {
+		return err
+	}
+	res, err := http.Post(SessionServerJoinURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK {
+		return errors.New("session server join failed: " + res.Status)
+	}
+	return nil
+}
+
+func (b *Bot) answerLoginPlugin(config Config, request *clientbound.LoginClientboundLoginPluginRequest) error {
+	res := serverbound.LoginServerboundLoginPluginResponse{MessageID: request.MessageID}
+	if answer, ok := config.LoginPlugins[request.Channel]; ok {
+		data := answer(request.Data)
+		if data == nil {
+			return nil
+		}
+		res.Data = &data
+	}
+	return b.send(&res)
+}
@@ -0,0 +1,61 @@
+package bot
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/network/messages/serverbound"
+)
+
+// Pings the server like the server list does, returning its status and the
+// round trip time of the ping.
+func Status(address string, protocol constants.ProtocolVersion) (*data.StatusResponse, time.Duration, error) {
+	if protocol == 0 {
+		protocol = constants.ProtocolLatest
+	}
+	conn, err := network.Dial(address)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	b := &Bot{Protocol: protocol, conn: conn}
+	if err := b.handshake(address, constants.ClientStateStatus); err != nil {
+		return nil, 0, err
+	}
+	if err := b.send(&serverbound.StatusServerboundStatusRequest{}); err != nil {
+		return nil, 0, err
+	}
+	msg, err := b.receive()
+	if err != nil {
+		return nil, 0, err
+	}
+	statusResponse, ok := msg.(*clientbound.StatusClientboundStatusResponse)
+	if !ok {
+		return nil, 0, fmt.Errorf("expected status response, got %T", msg)
+	}
+	var response data.StatusResponse
+	if err := json.Unmarshal([]byte(statusResponse.Response), &response); err != nil {
+		return nil, 0, err
+	}
+
+	start := time.Now()
+	challenge := start.UnixNano()
+	if err := b.send(&serverbound.StatusServerboundPing{Challenge: challenge}); err != nil {
+		return nil, 0, err
+	}
+	msg, err = b.receive()
+	if err != nil {
+		return nil, 0, err
+	}
+	if pong, ok := msg.(*clientbound.StatusClientboundPing); !ok || pong.Response != challenge {
+		return nil, 0, fmt.Errorf("unexpected ping response %+v", msg)
+	}
+	return &response, time.Since(start), nil
+}
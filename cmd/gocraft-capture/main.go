@@ -0,0 +1,250 @@
+// Inspects packet captures written by the server's -capture option:
+//
+//	gocraft-capture print [-hex] <file>
+//	gocraft-capture replay [-addr localhost:25565] [-speed 1] <file>
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/encryption"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/network/messages/serverbound"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: gocraft-capture print [-hex] <file>")
+	fmt.Fprintln(os.Stderr, "       gocraft-capture replay [-addr host:port] [-speed factor] <file>")
+	os.Exit(2)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "print":
+		flags := flag.NewFlagSet("print", flag.ExitOnError)
+		showHex := flags.Bool("hex", false, "also print packet bodies as hex")
+		flags.Parse(os.Args[2:])
+		if flags.NArg() != 1 {
+			usage()
+		}
+		err = printCapture(flags.Arg(0), *showHex)
+	case "replay":
+		flags := flag.NewFlagSet("replay", flag.ExitOnError)
+		address := flags.String("addr", "localhost:25565", "server to replay against")
+		speed := flags.Float64("speed", 1, "replay speed relative to the capture, 0 sends everything at once")
+		linger := flags.Duration("linger", 2*time.Second, "how long to stay connected after the last packet")
+		flags.Parse(os.Args[2:])
+		if flags.NArg() != 1 {
+			usage()
+		}
+		err = replay(flags.Arg(0), *address, *speed, *linger)
+	default:
+		usage()
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readCapture(path string) ([]network.CaptureRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []network.CaptureRecord
+	reader := network.NewCaptureReader(f)
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return records, fmt.Errorf("record %d: %w", len(records)+1, err)
+		}
+		records = append(records, record)
+	}
+}
+
+func decodeRecord(record *network.CaptureRecord) (any, error) {
+	packet := network.Packet{Id: record.Id, Body: record.Body}
+	if record.Direction == network.DirectionServerbound {
+		return messages.DecodeServerbound(record.Protocol, record.State, &packet)
+	}
+	return messages.DecodeClientbound(record.Protocol, record.State, &packet)
+}
+
+func printCapture(path string, showHex bool) error {
+	records, err := readCapture(path)
+	if err != nil {
+		return err
+	}
+
+	for i := range records {
+		record := &records[i]
+		elapsed := record.Time.Sub(records[0].Time).Seconds()
+		fmt.Printf("+%9.3fs %-11v %-24v 0x%02X ", elapsed, record.Direction, record.State, record.Id)
+
+		msg, err := decodeRecord(record)
+		if err != nil {
+			fmt.Printf("(%d bytes, %v)\n", len(record.Body), err)
+		} else {
+			v := reflect.ValueOf(msg).Elem()
+			fmt.Printf("%s %+v\n", v.Type().Name(), v.Interface())
+		}
+		if showHex && len(record.Body) > 0 {
+			fmt.Printf("%x\n", record.Body)
+		}
+	}
+	return nil
+}
+
+// Sends the serverbound packets of a capture to a server, with the original
+// timing scaled by speed. Encryption can't be replayed as captured, so the
+// replayer answers the server's encryption request itself and skips the
+// captured response. Anything else depending on values chosen by the server
+// (keep alive IDs, teleport IDs) is sent as captured.
+func replay(path string, address string, speed float64, linger time.Duration) error {
+	records, err := readCapture(path)
+	if err != nil {
+		return err
+	}
+
+	// The handshake is recorded before the server knows the client's version
+	protocol := constants.ProtocolLatest
+	for _, record := range records {
+		if record.State != constants.ClientStateHandshaking {
+			protocol = record.Protocol
+			break
+		}
+	}
+
+	conn, err := network.Dial(address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	log.Printf("Replaying %s against %s (protocol %d)", path, address, protocol)
+
+	encrypted := make(chan struct{})
+	received := make(chan int)
+	go func() {
+		received <- readReplies(conn, protocol, encrypted)
+	}()
+
+	var sent int
+	var prev time.Time
+	for i := range records {
+		record := &records[i]
+		if record.Direction != network.DirectionServerbound {
+			continue
+		}
+		if speed > 0 && !prev.IsZero() {
+			time.Sleep(time.Duration(float64(record.Time.Sub(prev)) / speed))
+		}
+		prev = record.Time
+
+		if msg, _ := decodeRecord(record); msg != nil {
+			if _, ok := msg.(*serverbound.LoginServerboundEncryptionResponse); ok {
+				select {
+				case <-encrypted:
+				case <-time.After(10 * time.Second):
+					return errors.New("server never sent an encryption request")
+				}
+				continue
+			}
+		}
+
+		packet := network.Packet{Id: record.Id, Body: record.Body}
+		if err := conn.WritePacket(&packet); err != nil {
+			return fmt.Errorf("sending record %d: %w", i+1, err)
+		}
+		sent++
+	}
+
+	time.Sleep(linger)
+	conn.Close()
+	log.Printf("Sent %d packets, received %d", sent, <-received)
+	return nil
+}
+
+// Reads packets until the connection closes, answering the login packets that
+// need the connection to change. Returns the number of packets read.
+func readReplies(conn *network.ClientConnection, protocol constants.ProtocolVersion, encrypted chan<- struct{}) int {
+	count := 0
+	login := true
+	for {
+		packet, err := conn.ReadPacket()
+		if err != nil {
+			return count
+		}
+		count++
+		if !login {
+			continue
+		}
+
+		msg, err := messages.DecodeClientbound(protocol, constants.ClientStateLogin, &packet)
+		if err != nil {
+			continue
+		}
+		switch m := msg.(type) {
+		case *clientbound.LoginClientboundEncryptionRequest:
+			if err := answerEncryption(conn, protocol, m); err != nil {
+				log.Println("Error answering encryption request:", err)
+				return count
+			}
+			close(encrypted)
+		case *clientbound.LoginClientboundSetCompression:
+			conn.SetCompression(int(m.Threshold))
+		case *clientbound.LoginClientboundSuccess:
+			login = false
+		case *clientbound.LoginClientboundDisconnect:
+			log.Println("Disconnected during login:", m.Reason.PlainText())
+		}
+	}
+}
+
+func answerEncryption(conn *network.ClientConnection, protocol constants.ProtocolVersion, m *clientbound.LoginClientboundEncryptionRequest) error {
+	sharedSecret := make([]byte, 16)
+	if _, err := rand.Read(sharedSecret); err != nil {
+		return err
+	}
+	encSharedSecret := bytes.Clone(sharedSecret)
+	if err := encryption.EncryptWithPublicKey(m.PublicKey, &encSharedSecret); err != nil {
+		return err
+	}
+	encVerifyToken := bytes.Clone(m.VerifyToken)
+	if err := encryption.EncryptWithPublicKey(m.PublicKey, &encVerifyToken); err != nil {
+		return err
+	}
+
+	packet, err := messages.EncodeServerbound(protocol, &serverbound.LoginServerboundEncryptionResponse{
+		SharedSecret: encSharedSecret,
+		VerifyToken:  encVerifyToken,
+	})
+	if err != nil {
+		return err
+	}
+	if err := conn.WritePacket(&packet); err != nil {
+		return err
+	}
+	return conn.EnableEncryption(sharedSecret)
+}
@@ -0,0 +1,253 @@
+// Simulates many clients against a server and reports how it held up:
+//
+//	go run ./cmd/gocraft-loadtest -addr localhost:25565 -bots 200 -rate 20 -duration 1m
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/brenfwd/gocraft/bot"
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+)
+
+type options struct {
+	address      string
+	bots         int
+	rate         float64
+	duration     time.Duration
+	protocol     int
+	namePrefix   string
+	movement     string
+	moveInterval time.Duration
+	chatInterval time.Duration
+	chatMessage  string
+}
+
+// Outcome of a single bot.
+type result struct {
+	joined       bool
+	loginLatency time.Duration
+	// Why the connection ended before the test did: the server's disconnect
+	// reason, or an error for anything else. Empty if it stayed connected.
+	disconnect   string
+	bytesRead    int64
+	bytesWritten int64
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.address, "addr", "localhost:25565", "server address")
+	flag.IntVar(&opts.bots, "bots", 10, "number of bots")
+	flag.Float64Var(&opts.rate, "rate", 5, "bots joining per second")
+	flag.DurationVar(&opts.duration, "duration", 30*time.Second, "how long bots stay connected after all have joined")
+	flag.IntVar(&opts.protocol, "protocol", int(constants.ProtocolLatest), "protocol version the bots use")
+	flag.StringVar(&opts.namePrefix, "name", "bot", "username prefix, followed by the bot number")
+	flag.StringVar(&opts.movement, "move", "walk", "movement pattern: none, walk (random walk) or circle")
+	flag.DurationVar(&opts.moveInterval, "move-interval", 50*time.Millisecond, "time between movement packets")
+	flag.DurationVar(&opts.chatInterval, "chat-interval", 10*time.Second, "time between chat messages, 0 to disable")
+	flag.StringVar(&opts.chatMessage, "chat", "hello from %s", "chat message, %s is replaced by the username")
+	flag.Parse()
+
+	switch opts.movement {
+	case "none", "walk", "circle":
+	default:
+		fmt.Fprintf(os.Stderr, "unknown movement pattern %q\n", opts.movement)
+		os.Exit(2)
+	}
+	if opts.rate <= 0 || opts.bots <= 0 {
+		fmt.Fprintln(os.Stderr, "-bots and -rate must be positive")
+		os.Exit(2)
+	}
+
+	log.Printf("Starting %d bots against %s at %.1f/s", opts.bots, opts.address, opts.rate)
+	start := time.Now()
+
+	// Bots run until stop is closed, which happens once the last one has had
+	// its chance to join plus the test duration.
+	stop := make(chan struct{})
+	results := make([]result, opts.bots)
+	var wg sync.WaitGroup
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / opts.rate))
+	for i := range opts.bots {
+		if i > 0 {
+			<-ticker.C
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = runBot(&opts, i, stop)
+		}()
+	}
+	ticker.Stop()
+
+	time.Sleep(opts.duration)
+	close(stop)
+	wg.Wait()
+
+	report(results, time.Since(start))
+}
+
+func runBot(opts *options, i int, stop <-chan struct{}) result {
+	var r result
+	username := fmt.Sprintf("%s%d", opts.namePrefix, i)
+
+	joinStart := time.Now()
+	b, err := bot.Join(bot.Config{
+		Address:  opts.address,
+		Username: username,
+		Protocol: constants.ProtocolVersion(opts.protocol),
+	})
+	if err != nil {
+		r.disconnect = describe(err)
+		return r
+	}
+	r.joined = true
+	r.loginLatency = time.Since(joinStart)
+
+	// Drain chat so the bot doesn't log about dropped messages
+	go func() {
+		for range b.Messages() {
+		}
+	}()
+
+	err = script(opts, b, stop)
+	if err == nil {
+		// Stopped normally
+		b.Close()
+	} else {
+		<-b.Done()
+		if b.Err() != nil {
+			err = b.Err()
+		}
+		r.disconnect = describe(err)
+	}
+	r.bytesRead = b.BytesRead()
+	r.bytesWritten = b.BytesWritten()
+	return r
+}
+
+// Moves and chats until stop is closed (returning nil) or the connection ends.
+func script(opts *options, b *bot.Bot, stop <-chan struct{}) error {
+	var move <-chan time.Time
+	if opts.movement != "none" {
+		ticker := time.NewTicker(opts.moveInterval)
+		defer ticker.Stop()
+		move = ticker.C
+	}
+	var chat <-chan time.Time
+	if opts.chatInterval > 0 {
+		ticker := time.NewTicker(opts.chatInterval)
+		defer ticker.Stop()
+		chat = ticker.C
+	}
+
+	origin := b.Position()
+	angle := rand.Float64() * 2 * math.Pi
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-b.Done():
+			return b.Err()
+		case <-move:
+			pos := b.Position()
+			switch opts.movement {
+			case "walk":
+				// Stay within a few blocks of spawn so the server never sees a
+				// move into an unloaded chunk
+				pos.X = clamp(pos.X+rand.Float64()*0.4-0.2, origin.X-8, origin.X+8)
+				pos.Z = clamp(pos.Z+rand.Float64()*0.4-0.2, origin.Z-8, origin.Z+8)
+			case "circle":
+				angle += 0.05
+				pos = data.Vec3{X: origin.X + 4*math.Cos(angle), Y: origin.Y, Z: origin.Z + 4*math.Sin(angle)}
+			}
+			if err := b.Move(pos); err != nil {
+				return err
+			}
+		case <-chat:
+			if err := b.Chat(fmt.Sprintf(opts.chatMessage, b.Username)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, v))
+}
+
+// Groups errors by the server's disconnect reason where there is one.
+func describe(err error) string {
+	var disconnect *bot.DisconnectError
+	if errors.As(err, &disconnect) {
+		return fmt.Sprintf("kicked in %v: %s", disconnect.State, disconnect.Reason.PlainText())
+	}
+	return err.Error()
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	return sorted[max(i, 0)]
+}
+
+func report(results []result, elapsed time.Duration) {
+	var joined, stillConnected int
+	var bytesRead, bytesWritten int64
+	latencies := make([]time.Duration, 0, len(results))
+	disconnects := make(map[string]int)
+	for _, r := range results {
+		if r.joined {
+			joined++
+			latencies = append(latencies, r.loginLatency)
+		}
+		if r.disconnect == "" {
+			stillConnected++
+		} else {
+			disconnects[r.disconnect]++
+		}
+		bytesRead += r.bytesRead
+		bytesWritten += r.bytesWritten
+	}
+	slices.Sort(latencies)
+
+	total := len(results)
+	fmt.Printf("\nRan %d bots for %v\n", total, elapsed.Round(time.Millisecond))
+	fmt.Printf("Joined:           %d/%d (%.1f%%)\n", joined, total, 100*float64(joined)/float64(total))
+	fmt.Printf("Stayed connected: %d/%d (%.1f%%)\n", stillConnected, total, 100*float64(stillConnected)/float64(total))
+	if len(latencies) > 0 {
+		fmt.Printf("Login latency:    p50 %v  p90 %v  p99 %v  max %v\n",
+			percentile(latencies, 50).Round(time.Microsecond),
+			percentile(latencies, 90).Round(time.Microsecond),
+			percentile(latencies, 99).Round(time.Microsecond),
+			latencies[len(latencies)-1].Round(time.Microsecond))
+	}
+	seconds := elapsed.Seconds()
+	fmt.Printf("Bytes in:         %d (%.1f KiB/s)\n", bytesRead, float64(bytesRead)/1024/seconds)
+	fmt.Printf("Bytes out:        %d (%.1f KiB/s)\n", bytesWritten, float64(bytesWritten)/1024/seconds)
+
+	if len(disconnects) > 0 {
+		fmt.Println("Disconnects:")
+		reasons := make([]string, 0, len(disconnects))
+		for reason := range disconnects {
+			reasons = append(reasons, reason)
+		}
+		sort.Slice(reasons, func(i, j int) bool { return disconnects[reasons[i]] > disconnects[reasons[j]] })
+		for _, reason := range reasons {
+			fmt.Printf("  %5d  %s\n", disconnects[reason], reason)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Runs gocraft as a proxy in front of other servers, which players can move
+// between with /server <name>:
+//
+//	go run ./cmd/gocraft-proxy -servers lobby=localhost:25566,survival=localhost:25567 -default lobby -fallback lobby \
+//		-forwarding velocity -forwarding-secret forwarding.secret
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/forwarding"
+	"github.com/brenfwd/gocraft/proxy"
+)
+
+// Parses a comma-separated list of key=value pairs.
+func parsePairs(s string) (map[string]string, error) {
+	pairs := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("expected key=value, got %q", pair)
+		}
+		pairs[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return pairs, nil
+}
+
+func main() {
+	address := flag.String("addr", "0.0.0.0:25577", "address to accept players on")
+	servers := flag.String("servers", "", "backend servers as comma-separated name=host:port pairs")
+	defaultServer := flag.String("default", "", "server players join")
+	routes := flag.String("routes", "", "servers for hostnames players connect with, as comma-separated hostname=name pairs")
+	fallback := flag.String("fallback", "", "server players are moved to when theirs disconnects them")
+	online := flag.Bool("online", false, "authenticate players with the session server")
+	forwardingMode := flag.String("forwarding", "none", "how player details are forwarded to backends: none, bungeecord or velocity")
+	forwardingSecret := flag.String("forwarding-secret", "", "file holding the secret for velocity forwarding")
+	trustedProxies := flag.String("trusted-proxies", "", "comma-separated CIDRs of load balancers that send a PROXY protocol header")
+	flag.Parse()
+
+	config := proxy.Config{
+		Default:    *defaultServer,
+		Fallback:   *fallback,
+		OnlineMode: *online,
+		Throttle:   network.DefaultThrottleConfig,
+	}
+	var err error
+	if config.Servers, err = parsePairs(*servers); err != nil {
+		log.Fatal("-servers: ", err)
+	}
+	if config.Routes, err = parsePairs(strings.ToLower(*routes)); err != nil {
+		log.Fatal("-routes: ", err)
+	}
+	switch *forwardingMode {
+	case "none":
+	case "bungeecord":
+		config.Forwarding.Mode = forwarding.ModeBungeeCord
+	case "velocity":
+		config.Forwarding.Mode = forwarding.ModeVelocity
+		secret, err := os.ReadFile(*forwardingSecret)
+		if err != nil {
+			log.Fatal("-forwarding-secret: ", err)
+		}
+		config.Forwarding.Secret = bytes.TrimSpace(secret)
+	default:
+		log.Fatalf("unknown forwarding mode %q", *forwardingMode)
+	}
+	for _, cidr := range strings.Split(*trustedProxies, ",") {
+		if cidr == "" {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+		if err != nil {
+			log.Fatal("-trusted-proxies: ", err)
+		}
+		config.TrustedProxies = append(config.TrustedProxies, prefix)
+	}
+
+	host, portString, err := net.SplitHostPort(*address)
+	if err != nil {
+		log.Fatal("-addr: ", err)
+	}
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		log.Fatal("-addr: ", err)
+	}
+	p, err := proxy.NewProxy(host, uint16(port), config)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt)
+		<-signals
+		p.Close()
+	}()
+	p.Run()
+}
@@ -0,0 +1,152 @@
+package command
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/permissions"
+)
+
+// Something that runs commands: the console, an RCON client or a player.
+type Sender interface {
+	permissions.Permissible
+	Name() string
+	SendMessage(*data.Chat)
+}
+
+type Command struct {
+	Name    string
+	Aliases []string
+	// The arguments, shown when the command is used wrongly, e.g.
+	// "<player> [reason]"
+	Usage       string
+	Description string
+	// Permission node needed to run the command. Defaults to
+	// "gocraft.command.<name>".
+	Permission string
+	// Operator level that grants Permission unless it was set otherwise, like
+	// vanilla's: 0 for everyone, 3 for managing players and 4 for managing the
+	// server
+	Level int
+	Run   func(sender Sender, args []string) error
+}
+
+// Whether sender may run the command.
+func (cmd *Command) Allowed(sender Sender) bool {
+	return permissions.Has(sender, cmd.Permission, cmd.Level)
+}
+
+// Returned by Run to show the sender the command's usage.
+var ErrUsage = errors.New("incorrect usage")
+
+// Looks up commands by name and runs them. Safe for concurrent use.
+type Dispatcher struct {
+	mu       sync.RWMutex
+	commands map[string]*Command
+}
+
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{commands: make(map[string]*Command)}
+}
+
+// Adds a command, replacing any with the same name or alias.
+func (d *Dispatcher) Register(cmd *Command) {
+	if cmd.Permission == "" {
+		cmd.Permission = "gocraft.command." + strings.ToLower(cmd.Name)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.commands[strings.ToLower(cmd.Name)] = cmd
+	for _, alias := range cmd.Aliases {
+		d.commands[strings.ToLower(alias)] = cmd
+	}
+}
+
+func (d *Dispatcher) Get(name string) (*Command, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	cmd, ok := d.commands[strings.ToLower(name)]
+	return cmd, ok
+}
+
+// The registered commands, sorted by name.
+func (d *Dispatcher) Commands() []*Command {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var commands []*Command
+	for name, cmd := range d.commands {
+		if name == strings.ToLower(cmd.Name) {
+			commands = append(commands, cmd)
+		}
+	}
+	slices.SortFunc(commands, func(a, b *Command) int { return strings.Compare(a.Name, b.Name) })
+	return commands
+}
+
+// Runs a command line (with or without the leading slash) as sender. Problems
+// are reported to the sender rather than returned.
+func (d *Dispatcher) Execute(sender Sender, line string) {
+	fields := strings.Fields(strings.TrimPrefix(strings.TrimSpace(line), "/"))
+	if len(fields) == 0 {
+		return
+	}
+	log.Printf("%s issued server command: /%s", sender.Name(), strings.Join(fields, " "))
+
+	cmd, ok := d.Get(fields[0])
+	if !ok {
+		sender.SendMessage(Error(fmt.Sprintf("Unknown command %q, type /help for a list of commands", fields[0])))
+		return
+	}
+	if !cmd.Allowed(sender) {
+		sender.SendMessage(Error("You don't have permission to use this command"))
+		return
+	}
+	err := cmd.Run(sender, fields[1:])
+	if errors.Is(err, ErrUsage) {
+		sender.SendMessage(Error(fmt.Sprintf("Usage: /%s %s", cmd.Name, cmd.Usage)))
+	} else if err != nil {
+		sender.SendMessage(Error(err.Error()))
+	}
+}
+
+// A red message, for commands that failed.
+func Error(text string) *data.Chat {
+	return data.MakeChat().SetText(text).SetColor(data.ChatColorRed)
+}
+
+// Runs commands typed into the server console. Their output is logged.
+type Console struct{}
+
+func (Console) Name() string {
+	return "Server"
+}
+
+func (Console) SendMessage(msg *data.Chat) {
+	log.Println(msg.PlainText())
+}
+
+// The console has every permission.
+func (Console) Permission(node string) (bool, bool) {
+	return true, true
+}
+
+func (Console) OpLevel() int {
+	return 4
+}
+
+// Runs each line read from r (usually stdin) as a console command, until r
+// ends.
+func (d *Dispatcher) RunConsole(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		d.Execute(Console{}, scanner.Text())
+	}
+	return scanner.Err()
+}
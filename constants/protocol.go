@@ -0,0 +1,48 @@
+package constants
+
+import "fmt"
+
+// Protocol version numbers as sent in the handshake.
+type ProtocolVersion int32
+
+const (
+	Protocol1_20_2 ProtocolVersion = 764
+	Protocol1_20_3 ProtocolVersion = 765 // also 1.20.4
+	Protocol1_20_5 ProtocolVersion = 766 // also 1.20.6
+	Protocol1_21   ProtocolVersion = 767 // also 1.21.1
+
+	ProtocolOldest = Protocol1_20_2
+	ProtocolLatest = Protocol1_21
+)
+
+var protocolNames = map[ProtocolVersion]string{
+	Protocol1_20_2: "1.20.2",
+	Protocol1_20_3: "1.20.3",
+	Protocol1_20_5: "1.20.5",
+	Protocol1_21:   "1.21",
+}
+
+// Protocol versions the server can speak, oldest first. Only packet IDs and
+// fields differ between them.
+//
+// TODO: block state and entity type IDs are always those of the latest version,
+// older clients will show some blocks and entities wrong.
+var SupportedProtocols = []ProtocolVersion{Protocol1_20_2, Protocol1_20_3, Protocol1_20_5, Protocol1_21}
+
+func (v ProtocolVersion) Supported() bool {
+	_, ok := protocolNames[v]
+	return ok
+}
+
+// The oldest Minecraft version using this protocol, e.g. "1.21".
+func (v ProtocolVersion) String() string {
+	if name, ok := protocolNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("protocol %d", int32(v))
+}
+
+// Range of supported Minecraft versions for display, e.g. "1.20.2-1.21".
+func SupportedVersionsName() string {
+	return ProtocolOldest.String() + "-" + ProtocolLatest.String()
+}
@@ -1,39 +1,63 @@
 package core
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"sync"
 
 	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/event"
 	"github.com/brenfwd/gocraft/network"
 	"github.com/brenfwd/gocraft/network/encryption"
 	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/network/messages/serverbound"
 	"github.com/brenfwd/gocraft/shared"
 )
 
 type Client struct {
 	Shared     *shared.ClientShared
 	State      constants.ClientState
-	connection network.Connection
+	connection *network.Connection
 }
 
-func NewClient(connection network.Connection) Client {
+func NewClient(connection *network.Connection, ctx shared.ServerContext) Client {
 	return Client{
-		Shared:     shared.NewClientShared(connection.Keypair),
+		Shared:     shared.NewClientShared(connection.RemoteAddr(), connection.Keypair, ctx),
 		State:      constants.ClientStateHandshaking,
 		connection: connection,
 	}
 }
 
 func (c *Client) processPacket(packet *network.Packet) error {
-	log.Printf("Packet from %s (%v): %+v", c.connection.RemoteAddr(), c.State, *packet)
+	c.connection.Record(network.DirectionServerbound, c.State, c.Shared.Protocol, packet)
 
-	decoded, err := messages.DecodeServerbound(c.State, packet)
+	if !event.Fire(c.Shared.Events, &event.PacketReceive{RemoteAddr: c.connection.RemoteAddr(), State: c.State, Packet: packet}) {
+		return nil
+	}
+
+	decoded, err := messages.DecodeServerbound(c.Shared.Protocol, c.State, packet)
+	if errors.Is(err, messages.ErrUnknownPacket) {
+		// Only packets we have a layout for are decoded strictly; ones missing
+		// from the ID tables aren't the client's fault
+		log.Printf("Ignoring unknown packet 0x%02X in state %v from %s", packet.Id, c.State, c.connection.RemoteAddr())
+		return nil
+	}
 	if err != nil {
 		return err
 	}
 
-	if err := decoded.Handle(c.Shared); err != nil {
+	// Generated messages without a hand-written Handle method are accepted
+	// but ignored.
+	handler, ok := decoded.(serverbound.Handler)
+	if !ok {
+		log.Printf("Ignoring unhandled message %T from %s", decoded, c.connection.RemoteAddr())
+		return nil
+	}
+
+	if err := handler.Handle(c.Shared); err != nil {
 		return err
 	}
 
@@ -45,10 +69,26 @@ func (c *Client) handleSharedMessage(msg *shared.ClientMessage) error {
 	case shared.ClientChangeState:
 		log.Printf("Changing state to %v", inner.NewState)
 		c.State = inner.NewState
+		if c.State == constants.ClientStatePlay {
+			c.connection.LoginComplete()
+		}
 	case shared.ClientSend:
-		log.Printf("Sending packet with ID 0x%02x (%d)", inner.Packet.Id, inner.Packet.Id)
-		log.Printf("Packet: %x", inner.Packet.Body)
-		if err := c.connection.WritePacket(inner.Packet); err != nil {
+		packet := inner.Packet
+		// Messages are usually encoded once for the latest version and shared
+		// between clients, so encode them again for older clients.
+		if packet.Message != nil && packet.Protocol != c.Shared.Protocol {
+			encoded, err := messages.EncodeFor(c.Shared.Protocol, packet.Message)
+			if err != nil {
+				log.Printf("Not sending %T to %s: %v", packet.Message, c.connection.RemoteAddr(), err)
+				return nil
+			}
+			packet = &encoded
+		}
+		if err := c.writePacket(packet); err != nil {
+			if errors.Is(err, network.ErrSlowClient) {
+				log.Printf("Disconnecting %s: %v", c.connection.RemoteAddr(), err)
+				return errDisconnected
+			}
 			return err
 		}
 	case shared.ClientEnableEncryption:
@@ -58,12 +98,103 @@ func (c *Client) handleSharedMessage(msg *shared.ClientMessage) error {
 			return err
 		}
 		c.connection.SetCrypter(crypter)
+	case shared.ClientKeepAlive:
+		return c.keepAlive()
+	case shared.ClientLoginPluginTimeout:
+		return c.Shared.AnswerLoginPluginRequest(inner.MessageID, event.LoginPluginResponse{TimedOut: true})
+	case shared.ClientDisconnect:
+		if err := c.sendDisconnect(inner.Reason); err != nil {
+			log.Println("Error sending disconnect:", err)
+		}
+		return errDisconnected
 	default:
 		log.Printf("Unknown client shared message type: %T", inner)
 	}
 	return nil
 }
 
+var errDisconnected = errors.New("client was disconnected")
+
+// Sends a keep alive in the states that have one, or disconnects the client if
+// it didn't answer the last one. Vanilla clients close connections that go
+// quiet for too long, so idle players need these.
+func (c *Client) keepAlive() error {
+	if c.State != constants.ClientStateConfiguration && c.State != constants.ClientStatePlay {
+		return nil
+	}
+	id, ok := c.Shared.NextKeepAlive()
+	if !ok {
+		log.Printf("Disconnecting %s: keep alive timed out", c.connection.RemoteAddr())
+		if err := c.sendDisconnect(shared.ReasonTimedOut); err != nil {
+			log.Println("Error sending disconnect:", err)
+		}
+		return errDisconnected
+	}
+	var msg any = &clientbound.PlayClientboundKeepAlive{KeepAliveID: id}
+	if c.State == constants.ClientStateConfiguration {
+		msg = &clientbound.ConfigurationClientboundKeepAlive{KeepAliveID: id}
+	}
+	encoded, err := messages.EncodeFor(c.Shared.Protocol, msg)
+	if err != nil {
+		return err
+	}
+	return c.writePacket(&encoded)
+}
+
+// Sends the disconnect packet matching the current state. Clients in the
+// handshaking and status states have no disconnect packet and are just closed.
+func (c *Client) sendDisconnect(reason *data.Chat) error {
+	if reason == nil {
+		reason = data.MakeChat().SetText("Disconnected")
+	}
+	var encoded network.Packet
+	var err error
+	switch c.State {
+	case constants.ClientStateLogin:
+		encoded, err = messages.EncodeFor(c.Shared.Protocol, &clientbound.LoginClientboundDisconnect{Reason: *reason})
+	case constants.ClientStateConfiguration:
+		encoded, err = messages.EncodeFor(c.Shared.Protocol, &clientbound.ConfigurationClientboundDisconnect{ReasonJSON: *reason, Reason: reason.ToNBT(nil)})
+	case constants.ClientStatePlay:
+		encoded, err = messages.EncodeFor(c.Shared.Protocol, &clientbound.PlayClientboundDisconnect{ReasonJSON: *reason, Reason: reason.ToNBT(nil)})
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return c.writePacket(&encoded)
+}
+
+// Tells the client why it is being disconnected if it sent a packet that could
+// not be decoded.
+func (c *Client) disconnectInvalid(err error) {
+	var decodeErr *messages.DecodeError
+	if !errors.As(err, &decodeErr) {
+		return
+	}
+	reason := data.MakeChat().SetText(fmt.Sprintf("Invalid packet 0x%02X: %v", decodeErr.Id, decodeErr.Err))
+	if err := c.sendDisconnect(reason); err != nil {
+		log.Println("Error sending disconnect:", err)
+	}
+}
+
+func (c *Client) writePacket(packet *network.Packet) error {
+	c.connection.Record(network.DirectionClientbound, c.State, c.Shared.Protocol, packet)
+	return c.connection.WritePacket(packet, priority(packet))
+}
+
+// Keep alives and disconnects skip ahead of queued packets, so a client that is
+// behind on chunks isn't timed out or left waiting for its kick message.
+func priority(packet *network.Packet) network.Priority {
+	switch packet.Message.(type) {
+	case *clientbound.ConfigurationClientboundKeepAlive, *clientbound.PlayClientboundKeepAlive,
+		*clientbound.LoginClientboundDisconnect, *clientbound.ConfigurationClientboundDisconnect,
+		*clientbound.PlayClientboundDisconnect:
+		return network.PriorityHigh
+	}
+	return network.PriorityNormal
+}
+
 func (c *Client) Handle() {
 	var wg sync.WaitGroup
 	defer wg.Wait()
@@ -74,29 +205,55 @@ func (c *Client) Handle() {
 		c.connection.Receive()
 	}()
 
+	flush := false
 	for {
 		// Process pending IPC messages first
 		for more_ipc := true; more_ipc; {
 			select {
 			case msg := <-c.Shared.C:
 				if err := c.handleSharedMessage(msg); err != nil {
-					log.Println("Error handling shared message:", err)
+					if !errors.Is(err, errDisconnected) {
+						log.Println("Error handling shared message:", err)
+					}
 					goto end
 				}
 			default:
 				more_ipc = false
 			}
 		}
+		// In play, packets go out once per tick, everything the tick queued in
+		// one write. Earlier states aren't driven by ticks, so their replies are
+		// sent as soon as they are queued.
+		if flush || c.State != constants.ClientStatePlay {
+			flush = false
+			if err := c.connection.Flush(); err != nil {
+				log.Println("Error sending packets:", err)
+				goto end
+			}
+		}
 
 		// Then process network packets & events and later IPC messages
 		select {
 		case <-c.connection.Eof:
 			log.Println("EOF", c.connection.RemoteAddr())
 			goto end
+		case <-c.Shared.Overflow():
+			reason := c.Shared.OverflowReason()
+			log.Printf("Disconnecting %s: %s", c.connection.RemoteAddr(), reason.PlainText())
+			if err := c.sendDisconnect(reason); err != nil {
+				log.Println("Error sending disconnect:", err)
+			}
+			goto end
+		case <-c.Shared.FlushRequests():
+			// The tick's messages are already in C, send them once they are
+			// handled
+			flush = true
 		case packet := <-c.connection.Packets:
 			err := c.processPacket(&packet)
+			packet.Release()
 			if err != nil {
 				log.Println("Error processing packet:", err)
+				c.disconnectInvalid(err)
 				goto end
 			}
 		case msg := <-c.Shared.C:
@@ -104,12 +261,25 @@ func (c *Client) Handle() {
 			// but then continue to the next iteration of the outer loop
 			// to handle any further IPC messages
 			if err := c.handleSharedMessage(msg); err != nil {
-				log.Println("Error handling shared message:", err)
+				if !errors.Is(err, errDisconnected) {
+					log.Println("Error handling shared message:", err)
+				}
 				goto end
 			}
 		}
 	}
 
 end:
+	c.Shared.CancelLoginPluginRequests()
+	if c.Shared.Player != nil {
+		c.Shared.World.RemovePlayer(c.Shared.Player)
+		// A replaced session was saved when the player logged in again
+		if c.Shared.PlayerData != nil && !c.Shared.Player.Replaced() {
+			if err := c.Shared.PlayerData.Save(c.Shared.Player); err != nil {
+				log.Printf("Error saving player data of %s: %v", c.Shared.Player.Username, err)
+			}
+		}
+		event.Fire(c.Shared.Events, &event.Quit{Player: c.Shared.Player})
+	}
 	c.connection.Close()
 }
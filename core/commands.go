@@ -0,0 +1,395 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brenfwd/gocraft/access"
+	"github.com/brenfwd/gocraft/command"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network/forwarding"
+	"github.com/brenfwd/gocraft/world"
+	"github.com/google/uuid"
+)
+
+var errAccessDisabled = errors.New("The whitelist and ban lists are disabled on this server")
+
+// Registers the built-in commands, which mirror vanilla's.
+func (s *Server) registerCommands() {
+	for _, cmd := range []*command.Command{
+		{Name: "help", Description: "Lists the commands you can use", Run: s.helpCommand},
+		{Name: "list", Description: "Lists the players online", Run: s.listCommand},
+		{Name: "kick", Usage: "<player> [reason]", Description: "Disconnects a player", Level: 3, Run: s.kickCommand},
+		{Name: "ban", Usage: "<player> [reason]", Description: "Bans a player", Level: 3, Run: s.banCommand},
+		{Name: "tempban", Usage: "<player> <duration> [reason]", Description: "Bans a player for a while, e.g. 30m, 12h or 7d", Level: 3, Run: s.tempbanCommand},
+		{Name: "ban-ip", Usage: "<address|player> [reason]", Description: "Bans an IP address", Level: 3, Run: s.banIPCommand},
+		{Name: "pardon", Usage: "<player>", Description: "Unbans a player", Level: 3, Run: s.pardonCommand},
+		{Name: "pardon-ip", Usage: "<address>", Description: "Unbans an IP address", Level: 3, Run: s.pardonIPCommand},
+		{Name: "banlist", Usage: "[players|ips]", Description: "Lists the bans", Level: 3, Run: s.banlistCommand},
+		{Name: "whitelist", Usage: "on|off|list|add <player>|remove <player>|reload", Description: "Manages the whitelist", Level: 3, Run: s.whitelistCommand},
+		{Name: "op", Usage: "<player> [level]", Description: "Makes a player an operator", Level: 3, Run: s.opCommand},
+		{Name: "deop", Usage: "<player>", Description: "Makes a player no longer an operator", Level: 3, Run: s.deopCommand},
+		{Name: "stop", Description: "Saves the players and stops the server", Level: 4, Run: s.stopCommand},
+		{Name: "perm", Usage: permUsage, Description: "Manages permission groups and nodes", Level: 4, Run: s.permCommand},
+	} {
+		s.Commands.Register(cmd)
+	}
+}
+
+func (s *Server) helpCommand(sender command.Sender, args []string) error {
+	for _, cmd := range s.Commands.Commands() {
+		if cmd.Allowed(sender) {
+			sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("/%s %s - %s", cmd.Name, cmd.Usage, cmd.Description)))
+		}
+	}
+	return nil
+}
+
+func (s *Server) listCommand(sender command.Sender, args []string) error {
+	players := s.world.Players()
+	names := make([]string, len(players))
+	for i, p := range players {
+		names[i] = p.Username
+	}
+	sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("There are %d players online: %s", len(players), strings.Join(names, ", "))))
+	return nil
+}
+
+func (s *Server) stopCommand(sender command.Sender, args []string) error {
+	sender.SendMessage(data.MakeChat().SetText("Stopping the server"))
+	// Not from here: closing disconnects the sender, who may be waiting on
+	// this command
+	go s.Close()
+	return nil
+}
+
+func (s *Server) kickCommand(sender command.Sender, args []string) error {
+	if len(args) < 1 {
+		return command.ErrUsage
+	}
+	player := s.playerByName(args[0])
+	if player == nil {
+		return fmt.Errorf("%s is not online", args[0])
+	}
+	reason := reasonOr(args[1:], "Kicked by an operator")
+	player.Kick(data.MakeChat().SetText(reason))
+	sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Kicked %s: %s", player.Username, reason)))
+	return nil
+}
+
+func (s *Server) banCommand(sender command.Sender, args []string) error {
+	if len(args) < 1 {
+		return command.ErrUsage
+	}
+	return s.banPlayer(sender, args[0], 0, args[1:])
+}
+
+func (s *Server) tempbanCommand(sender command.Sender, args []string) error {
+	if len(args) < 2 {
+		return command.ErrUsage
+	}
+	duration, err := parseDuration(args[1])
+	if err != nil {
+		return err
+	}
+	return s.banPlayer(sender, args[0], duration, args[2:])
+}
+
+// Bans a player, for good if duration is zero, and kicks them if they're
+// online.
+func (s *Server) banPlayer(sender command.Sender, name string, duration time.Duration, reasonArgs []string) error {
+	if s.Access == nil {
+		return errAccessDisabled
+	}
+	id, name, err := s.profile(name)
+	if err != nil {
+		return err
+	}
+	entry := access.BanEntry{UUID: id, Name: name, Ban: newBan(sender, duration, reasonOr(reasonArgs, "Banned by an operator"))}
+	if err := s.Access.BannedPlayers.Add(entry); err != nil {
+		return err
+	}
+	if player := s.world.PlayerByUUID(id); player != nil {
+		player.Kick(s.Access.CheckLogin(id, player.Address))
+	}
+	sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Banned %s: %s", name, entry.Reason)))
+	return nil
+}
+
+func (s *Server) banIPCommand(sender command.Sender, args []string) error {
+	if len(args) < 1 {
+		return command.ErrUsage
+	}
+	if s.Access == nil {
+		return errAccessDisabled
+	}
+	addr, err := netip.ParseAddr(args[0])
+	if err != nil {
+		player := s.playerByName(args[0])
+		if player == nil {
+			return fmt.Errorf("%q is neither an IP address nor an online player", args[0])
+		}
+		addr = player.Address
+	}
+	addr = addr.Unmap()
+
+	entry := access.IPBanEntry{IP: addr, Ban: newBan(sender, 0, reasonOr(args[1:], "Banned by an operator"))}
+	if err := s.Access.BannedIPs.Add(entry); err != nil {
+		return err
+	}
+	for _, player := range s.world.Players() {
+		if player.Address == addr {
+			player.Kick(s.Access.CheckLogin(player.UUID, addr))
+		}
+	}
+	sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Banned IP %s: %s", addr, entry.Reason)))
+	return nil
+}
+
+func (s *Server) pardonCommand(sender command.Sender, args []string) error {
+	if len(args) != 1 {
+		return command.ErrUsage
+	}
+	if s.Access == nil {
+		return errAccessDisabled
+	}
+	id, name, err := s.profile(args[0])
+	if err != nil {
+		return err
+	}
+	removed, err := s.Access.BannedPlayers.Remove(id)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return fmt.Errorf("%s is not banned", name)
+	}
+	sender.SendMessage(data.MakeChat().SetText("Unbanned " + name))
+	return nil
+}
+
+func (s *Server) pardonIPCommand(sender command.Sender, args []string) error {
+	if len(args) != 1 {
+		return command.ErrUsage
+	}
+	if s.Access == nil {
+		return errAccessDisabled
+	}
+	addr, err := netip.ParseAddr(args[0])
+	if err != nil {
+		return fmt.Errorf("Invalid IP address %q", args[0])
+	}
+	removed, err := s.Access.BannedIPs.Remove(addr.Unmap())
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return fmt.Errorf("%s is not banned", addr)
+	}
+	sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Unbanned IP %s", addr)))
+	return nil
+}
+
+func (s *Server) banlistCommand(sender command.Sender, args []string) error {
+	if len(args) > 1 || (len(args) == 1 && args[0] != "players" && args[0] != "ips") {
+		return command.ErrUsage
+	}
+	if s.Access == nil {
+		return errAccessDisabled
+	}
+	var lines []string
+	now := time.Now()
+	if len(args) == 0 || args[0] == "players" {
+		for _, ban := range s.Access.BannedPlayers.Entries() {
+			if !ban.Expired(now) {
+				lines = append(lines, describeBan(ban.Name, ban.Ban))
+			}
+		}
+	}
+	if len(args) == 0 || args[0] == "ips" {
+		for _, ban := range s.Access.BannedIPs.Entries() {
+			if !ban.Expired(now) {
+				lines = append(lines, describeBan(ban.IP.String(), ban.Ban))
+			}
+		}
+	}
+	sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("There are %d ban(s)", len(lines))))
+	for _, line := range lines {
+		sender.SendMessage(data.MakeChat().SetText(line))
+	}
+	return nil
+}
+
+func (s *Server) whitelistCommand(sender command.Sender, args []string) error {
+	if len(args) < 1 {
+		return command.ErrUsage
+	}
+	if s.Access == nil {
+		return errAccessDisabled
+	}
+	switch {
+	case args[0] == "on" && len(args) == 1:
+		s.Access.SetWhitelistEnabled(true)
+		sender.SendMessage(data.MakeChat().SetText("Whitelist is now turned on"))
+	case args[0] == "off" && len(args) == 1:
+		s.Access.SetWhitelistEnabled(false)
+		sender.SendMessage(data.MakeChat().SetText("Whitelist is now turned off"))
+	case args[0] == "list" && len(args) == 1:
+		entries := s.Access.Whitelist.Entries()
+		names := make([]string, len(entries))
+		for i, entry := range entries {
+			names[i] = entry.Name
+		}
+		sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("There are %d whitelisted players: %s", len(names), strings.Join(names, ", "))))
+	case args[0] == "add" && len(args) == 2:
+		id, name, err := s.profile(args[1])
+		if err != nil {
+			return err
+		}
+		if err := s.Access.Whitelist.Add(access.WhitelistEntry{UUID: id, Name: name}); err != nil {
+			return err
+		}
+		sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Added %s to the whitelist", name)))
+	case args[0] == "remove" && len(args) == 2:
+		id, name, err := s.profile(args[1])
+		if err != nil {
+			return err
+		}
+		removed, err := s.Access.Whitelist.Remove(id)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return fmt.Errorf("%s is not whitelisted", name)
+		}
+		sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Removed %s from the whitelist", name)))
+	case args[0] == "reload" && len(args) == 1:
+		if err := s.Access.Reload(); err != nil {
+			return err
+		}
+		sender.SendMessage(data.MakeChat().SetText("Reloaded the whitelist, operators and bans"))
+	default:
+		return command.ErrUsage
+	}
+	return nil
+}
+
+func (s *Server) opCommand(sender command.Sender, args []string) error {
+	if len(args) < 1 || len(args) > 2 {
+		return command.ErrUsage
+	}
+	if s.Access == nil {
+		return errAccessDisabled
+	}
+	level := 4
+	if len(args) == 2 {
+		var err error
+		level, err = strconv.Atoi(args[1])
+		if err != nil || level < 1 || level > 4 {
+			return fmt.Errorf("The level must be 1 to 4")
+		}
+	}
+	// Nobody can make someone more powerful than themselves
+	if level > sender.OpLevel() {
+		return fmt.Errorf("You can only give up to level %d", sender.OpLevel())
+	}
+	id, name, err := s.profile(args[0])
+	if err != nil {
+		return err
+	}
+	if err := s.Access.Ops.Add(access.OpEntry{UUID: id, Name: name, Level: level}); err != nil {
+		return err
+	}
+	sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Made %s a server operator", name)))
+	return nil
+}
+
+func (s *Server) deopCommand(sender command.Sender, args []string) error {
+	if len(args) != 1 {
+		return command.ErrUsage
+	}
+	if s.Access == nil {
+		return errAccessDisabled
+	}
+	id, name, err := s.profile(args[0])
+	if err != nil {
+		return err
+	}
+	removed, err := s.Access.Ops.Remove(id)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return fmt.Errorf("%s is not an operator", name)
+	}
+	sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Made %s no longer a server operator", name)))
+	return nil
+}
+
+func (s *Server) playerByName(name string) *world.Player {
+	for _, p := range s.world.Players() {
+		if strings.EqualFold(p.Username, name) {
+			return p
+		}
+	}
+	return nil
+}
+
+// The UUID and name of a player, online or not. Players that aren't online
+// get their offline-mode UUID, which is only right for offline-mode servers:
+// behind a proxy in online mode they have to be online to be looked up.
+func (s *Server) profile(name string) (uuid.UUID, string, error) {
+	if p := s.playerByName(name); p != nil {
+		return p.UUID, p.Username, nil
+	}
+	if !data.ValidUsername(name) {
+		return uuid.UUID{}, "", fmt.Errorf("Invalid player name %q", name)
+	}
+	if s.Forwarding.Mode != forwarding.ModeNone {
+		return uuid.UUID{}, "", fmt.Errorf("%s is not online", name)
+	}
+	return data.OfflineUUID(name), name, nil
+}
+
+func newBan(sender command.Sender, duration time.Duration, reason string) access.Ban {
+	ban := access.Ban{Created: time.Now(), Source: sender.Name(), Reason: reason}
+	if duration > 0 {
+		ban.Expires = ban.Created.Add(duration)
+	}
+	return ban
+}
+
+func describeBan(target string, ban access.Ban) string {
+	text := fmt.Sprintf("%s was banned by %s: %s", target, ban.Source, ban.Reason)
+	if !ban.Expires.IsZero() {
+		text += fmt.Sprintf(" (until %s)", ban.Expires.Format(time.DateTime))
+	}
+	return text
+}
+
+func reasonOr(args []string, fallback string) string {
+	if len(args) == 0 {
+		return fallback
+	}
+	return strings.Join(args, " ")
+}
+
+// Like time.ParseDuration, but also accepts days, e.g. "7d".
+func parseDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("Invalid duration %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("Invalid duration %q", s)
+	}
+	return d, nil
+}
@@ -0,0 +1,176 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/brenfwd/gocraft/command"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/google/uuid"
+)
+
+var errPermissionsDisabled = errors.New("Permissions are disabled on this server")
+
+// Players' permissions from the permissions file and their operator levels
+// from the ops list.
+type permissionSource struct {
+	s *Server
+}
+
+func (ps permissionSource) Permission(id uuid.UUID, node string) (bool, bool) {
+	if ps.s.Permissions == nil {
+		return false, false
+	}
+	return ps.s.Permissions.Permission(id, node)
+}
+
+func (ps permissionSource) OpLevel(id uuid.UUID) int {
+	if ps.s.Access == nil {
+		return 0
+	}
+	return ps.s.Access.OpLevel(id)
+}
+
+const permUsage = "groups|reload|check <player> <node>|player <player> info|set <node> [true|false]|unset <node>|addgroup <group>|removegroup <group>|group <group> info|set <node> [true|false]|unset <node>|inherit [groups...]"
+
+func (s *Server) permCommand(sender command.Sender, args []string) error {
+	if len(args) < 1 {
+		return command.ErrUsage
+	}
+	if s.Permissions == nil {
+		return errPermissionsDisabled
+	}
+	reply := func(format string, a ...any) {
+		sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf(format, a...)))
+	}
+
+	switch {
+	case args[0] == "groups" && len(args) == 1:
+		reply("Groups: %s", strings.Join(s.Permissions.Groups(), ", "))
+	case args[0] == "reload" && len(args) == 1:
+		if err := s.Permissions.Reload(); err != nil {
+			return err
+		}
+		reply("Reloaded the permissions")
+	case args[0] == "check" && len(args) == 3:
+		id, name, err := s.profile(args[1])
+		if err != nil {
+			return err
+		}
+		node := strings.ToLower(args[2])
+		if value, ok := s.Permissions.Permission(id, node); ok {
+			reply("%s is set to %t for %s", node, value, name)
+		} else {
+			reply("%s is not set for %s, so it depends on their operator level (%d)", node, name, permissionSource{s}.OpLevel(id))
+		}
+	case args[0] == "player" && len(args) >= 3:
+		return s.permPlayer(sender, args[1], args[2], args[3:])
+	case args[0] == "group" && len(args) >= 3:
+		return s.permGroup(sender, args[1], args[2], args[3:])
+	default:
+		return command.ErrUsage
+	}
+	return nil
+}
+
+func (s *Server) permPlayer(sender command.Sender, target string, action string, args []string) error {
+	id, name, err := s.profile(target)
+	if err != nil {
+		return err
+	}
+	switch {
+	case action == "info" && len(args) == 0:
+		player, _ := s.Permissions.Player(id)
+		sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("%s is in groups [%s] and has [%s]", name, strings.Join(player.Groups, ", "), player.Permissions)))
+	case action == "set" && (len(args) == 1 || len(args) == 2):
+		value, err := permValue(args[1:])
+		if err != nil {
+			return err
+		}
+		node := strings.ToLower(args[0])
+		if err := s.Permissions.SetPlayerPermission(id, name, node, value); err != nil {
+			return err
+		}
+		sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Set %s to %t for %s", node, value, name)))
+	case action == "unset" && len(args) == 1:
+		node := strings.ToLower(args[0])
+		removed, err := s.Permissions.UnsetPlayerPermission(id, node)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return fmt.Errorf("%s is not set for %s", node, name)
+		}
+		sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Unset %s for %s", node, name)))
+	case action == "addgroup" && len(args) == 1:
+		if err := s.Permissions.AddPlayerGroup(id, name, args[0]); err != nil {
+			return err
+		}
+		sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Added %s to %s", name, args[0])))
+	case action == "removegroup" && len(args) == 1:
+		removed, err := s.Permissions.RemovePlayerGroup(id, args[0])
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return fmt.Errorf("%s is not in %s", name, args[0])
+		}
+		sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Removed %s from %s", name, args[0])))
+	default:
+		return command.ErrUsage
+	}
+	return nil
+}
+
+func (s *Server) permGroup(sender command.Sender, group string, action string, args []string) error {
+	switch {
+	case action == "info" && len(args) == 0:
+		g, ok := s.Permissions.Group(group)
+		if !ok {
+			return fmt.Errorf("No group named %q", group)
+		}
+		sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("%s inherits [%s] and has [%s]", group, strings.Join(g.Inherits, ", "), g.Permissions)))
+	case action == "set" && (len(args) == 1 || len(args) == 2):
+		value, err := permValue(args[1:])
+		if err != nil {
+			return err
+		}
+		node := strings.ToLower(args[0])
+		if err := s.Permissions.SetGroupPermission(group, node, value); err != nil {
+			return err
+		}
+		sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Set %s to %t for %s", node, value, group)))
+	case action == "unset" && len(args) == 1:
+		node := strings.ToLower(args[0])
+		removed, err := s.Permissions.UnsetGroupPermission(group, node)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return fmt.Errorf("%s is not set for %s", node, group)
+		}
+		sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("Unset %s for %s", node, group)))
+	case action == "inherit":
+		if err := s.Permissions.SetGroupInherits(group, args); err != nil {
+			return err
+		}
+		sender.SendMessage(data.MakeChat().SetText(fmt.Sprintf("%s now inherits [%s]", group, strings.Join(args, ", "))))
+	default:
+		return command.ErrUsage
+	}
+	return nil
+}
+
+// The optional value of "perm ... set", true by default.
+func permValue(args []string) (bool, error) {
+	if len(args) == 0 {
+		return true, nil
+	}
+	value, err := strconv.ParseBool(args[0])
+	if err != nil {
+		return false, fmt.Errorf("Invalid value %q, use true or false", args[0])
+	}
+	return value, nil
+}
@@ -1,58 +1,221 @@
 package core
 
 import (
+	"fmt"
 	"log"
+	"net"
+	"net/netip"
+	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/brenfwd/gocraft/access"
+	"github.com/brenfwd/gocraft/command"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/event"
 	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/forwarding"
+	"github.com/brenfwd/gocraft/network/rcon"
+	"github.com/brenfwd/gocraft/permissions"
+	"github.com/brenfwd/gocraft/playerdata"
+	"github.com/brenfwd/gocraft/scheduler"
+	"github.com/brenfwd/gocraft/shared"
+	"github.com/brenfwd/gocraft/world"
 )
 
 type Server struct {
-	listener network.Listener
-	clients  []*Client
+	Scheduler *scheduler.Scheduler
+	Events    *event.Bus
+	// If set, the packets of each connection are captured to a file in this
+	// directory.
+	CaptureDir string
+	// Limits on incoming connections, applied from Run on
+	Throttle network.ThrottleConfig
+	// Load balancers in front of the server that send a PROXY protocol header,
+	// applied from Run on
+	TrustedProxies []netip.Prefix
+	// Set when the server runs behind BungeeCord or Velocity. The proxies are
+	// exempt from Throttle from Run on.
+	Forwarding forwarding.Config
+	// Whitelist, operators and bans, checked on login and reloaded when their
+	// files change from Run on. Nil to let everyone in.
+	Access *access.Lists
+	// Commands for the console, RCON clients and players
+	Commands *command.Dispatcher
+	// Permission nodes of players and groups. Nil to only go by the ops list.
+	Permissions *permissions.Manager
+	// If RconPassword is set, RCON clients are accepted on RconAddr from Run
+	// on
+	RconAddr     string
+	RconPassword string
+	// Where players are loaded from when they join and saved to when they
+	// leave, every autosaveInterval and on Close. Nil to not keep them.
+	PlayerData *playerdata.Store
+
+	rcon      *rcon.Server
+	listener  network.Listener
+	world     *world.World
+	tickStats tickStats
+	// Closed when the server shuts down
+	stop      chan struct{}
+	closeOnce sync.Once
+
+	clientsMu sync.Mutex
+	clients   map[*Client]struct{}
 }
 
-func NewServer() (Server, error) {
+// How often the access list files are checked for changes.
+const accessReloadInterval = 2 * time.Second
+
+// How often the players are saved, in ticks (5 minutes, like vanilla).
+const autosaveInterval = 6000
+
+// How often clients are sent keep alives, in ticks (15 seconds, like vanilla).
+// Clients that haven't answered by the next one are disconnected.
+const keepAliveInterval = 300
+
+func NewServer() (*Server, error) {
+	return NewServerAt("0.0.0.0", 25565)
+}
+
+// Creates a server listening on the given address. Port 0 picks a free port,
+// see Addr.
+func NewServerAt(host string, port uint16) (*Server, error) {
 	log.Println("gocraft server is starting...")
-	listener, err := network.NewListener("0.0.0.0", 25565)
+	listener, err := network.NewListener(host, port)
 	if err != nil {
-		return Server{}, err
+		return nil, err
 	}
 
-	return Server{listener, make([]*Client, 0)}, nil
+	s := &Server{
+		Scheduler: scheduler.New(),
+		Events:    event.NewBus(),
+		Commands:  command.NewDispatcher(),
+		RconAddr:  "0.0.0.0:25575",
+		listener:  listener,
+		clients:   make(map[*Client]struct{}),
+		world:     world.NewWorld(),
+		stop:      make(chan struct{}),
+		Throttle:  network.DefaultThrottleConfig,
+	}
+	s.registerCommands()
+	return s, nil
 }
 
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Enforces Throttle, and holds the temporary ban list. Nil until Run.
+func (s *Server) Throttler() *network.Throttler {
+	return s.listener.Throttle
+}
+
+// Stops accepting connections and disconnects every client, which saves the
+// players. Run returns once they are all gone. Closing again does nothing.
 func (s *Server) Close() error {
-	log.Println("gocraft server is shutting down...")
-	if err := s.listener.Close(); err != nil {
-		return err
-	}
-	// s.wg.Wait()
-	return nil
+	var err error
+	s.closeOnce.Do(func() {
+		log.Println("gocraft server is shutting down...")
+		close(s.stop)
+		if s.rcon != nil {
+			s.rcon.Close()
+		}
+		err = s.listener.Close()
+
+		s.clientsMu.Lock()
+		defer s.clientsMu.Unlock()
+		for client := range s.clients {
+			client.Shared.Disconnect(data.MakeChat().SetText("Server closed"))
+		}
+	})
+	return err
 }
 
+// Serves clients until Close is called.
 func (s *Server) Run() {
 	var wg sync.WaitGroup
 	defer wg.Wait()
 
 	// Start listener
+	throttle := s.Throttle
+	if s.Forwarding.Mode != forwarding.ModeNone {
+		throttle.Exempt = slices.Concat(throttle.Exempt, s.Forwarding.Proxies)
+	}
+	s.listener.Throttle = network.NewThrottler(throttle)
+	s.listener.TrustedProxies = s.TrustedProxies
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		s.listener.Listen()
 	}()
 
+	// Start game clock
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.runTickLoop(s.stop)
+	}()
+
+	if s.Access != nil {
+		go s.Access.Watch(accessReloadInterval, s.stop)
+	}
+	s.Scheduler.RunRepeating(keepAliveInterval, keepAliveInterval, s.keepAliveClients)
+	if s.PlayerData != nil {
+		s.Scheduler.RunRepeating(autosaveInterval, autosaveInterval, func() {
+			// Off the tick thread, the disk can be slow
+			go s.PlayerData.SaveAll(s.world.Players())
+		})
+	}
+	if s.RconPassword != "" {
+		var err error
+		if s.rcon, err = rcon.Listen(s.RconAddr, s.RconPassword, s.Commands); err != nil {
+			log.Println("Error starting RCON:", err)
+		} else {
+			go s.rcon.Serve()
+		}
+	}
+
 	log.Println("Server is ready")
 
 	for conn := range s.listener.Incoming {
 		log.Println("Got connection:", conn.RemoteAddr())
+		if s.CaptureDir != "" {
+			s.startCapture(conn)
+		}
 
-		client := NewClient(conn)
-		s.clients = append(s.clients, &client)
+		client := NewClient(conn, shared.ServerContext{
+			World:       s.world,
+			Scheduler:   s.Scheduler,
+			Events:      s.Events,
+			Forwarding:  s.Forwarding,
+			Access:      s.Access,
+			Commands:    s.Commands,
+			Permissions: permissionSource{s},
+			PlayerData:  s.PlayerData,
+		})
+		s.clientsMu.Lock()
+		select {
+		case <-s.stop:
+			// Accepted just before the listener closed
+			s.clientsMu.Unlock()
+			conn.Close()
+			continue
+		default:
+		}
+		s.clients[&client] = struct{}{}
+		s.clientsMu.Unlock()
 
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
+			defer func() {
+				s.clientsMu.Lock()
+				delete(s.clients, &client)
+				s.clientsMu.Unlock()
+			}()
 
 			client.Shared.Mutex.Lock()
 			defer client.Shared.Mutex.Unlock()
@@ -61,3 +224,32 @@ func (s *Server) Run() {
 		}()
 	}
 }
+
+func (s *Server) keepAliveClients() {
+	s.clientsMu.Lock()
+	defer s.clientsMu.Unlock()
+	for client := range s.clients {
+		client.Shared.KeepAlive()
+	}
+}
+
+// Has every client send what the tick queued for it.
+func (s *Server) flushClients() {
+	s.clientsMu.Lock()
+	defer s.clientsMu.Unlock()
+	for client := range s.clients {
+		client.Shared.RequestFlush()
+	}
+}
+
+func (s *Server) startCapture(conn *network.Connection) {
+	name := fmt.Sprintf("%s-%s.capture", time.Now().Format("20060102-150405.000"), conn.RemoteAddr())
+	path := filepath.Join(s.CaptureDir, strings.ReplaceAll(name, ":", "_"))
+	recorder, err := network.CreateRecorder(path)
+	if err != nil {
+		log.Println("Error starting packet capture:", err)
+		return
+	}
+	log.Println("Capturing packets to", path)
+	conn.SetRecorder(recorder)
+}
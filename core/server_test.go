@@ -0,0 +1,183 @@
+package core_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brenfwd/gocraft/bot"
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/core"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/event"
+	"github.com/brenfwd/gocraft/world/block"
+)
+
+const timeout = 2 * time.Second
+
+// Runs a server on a free port until the test ends, then checks that it shuts
+// down.
+func startServer(t *testing.T) *core.Server {
+	t.Helper()
+	s, err := core.NewServerAt("127.0.0.1", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	go func() {
+		s.Run()
+		close(done)
+	}()
+	t.Cleanup(func() {
+		s.Close()
+		select {
+		case <-done:
+		case <-time.After(timeout):
+			t.Error("Run didn't return after Close")
+		}
+	})
+	return s
+}
+
+func join(t *testing.T, s *core.Server, username string, protocol constants.ProtocolVersion) *bot.Bot {
+	t.Helper()
+	b, err := bot.Join(bot.Config{Address: s.Addr().String(), Username: username, Protocol: protocol})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { b.Close() })
+	return b
+}
+
+// Waits until cond holds, failing the test if it doesn't in time.
+func eventually(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func receive[T any](t *testing.T, c <-chan T, what string) T {
+	t.Helper()
+	select {
+	case v := <-c:
+		return v
+	case <-time.After(timeout):
+		t.Fatal("timed out waiting for", what)
+		panic("unreachable")
+	}
+}
+
+func TestJoinMoveChatDig(t *testing.T) {
+	s := startServer(t)
+	moves := make(chan data.Vec3, 16)
+	event.Subscribe(s.Events, event.PriorityMonitor, func(e *event.Move) {
+		moves <- e.To
+	})
+	breaks := make(chan data.Position, 16)
+	event.Subscribe(s.Events, event.PriorityMonitor, func(e *event.BlockBreak) {
+		breaks <- e.Position
+	})
+
+	// The second bot is on the oldest version, so everything it sees is
+	// encoded again for it
+	one := join(t, s, "One", constants.ProtocolLatest)
+	two := join(t, s, "Two", constants.ProtocolOldest)
+
+	eventually(t, "the bots to see each other in the player list", func() bool {
+		return one.PlayerList()[two.UUID] == "Two" && two.PlayerList()[one.UUID] == "One"
+	})
+
+	to := data.Vec3{X: 1.5, Y: 64, Z: 0.5}
+	if err := one.Move(to); err != nil {
+		t.Fatal(err)
+	}
+	if got := receive(t, moves, "the move"); got != to {
+		t.Errorf("server saw a move to %v, want %v", got, to)
+	}
+
+	if err := one.Chat("hello"); err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range []*bot.Bot{one, two} {
+		isHello := func(msg string) bool { return strings.Contains(msg, "hello") }
+		msg, err := b.WaitForMessage(isHello, timeout)
+		if err != nil {
+			t.Fatalf("%s: %v", b.Username, err)
+		}
+		if msg != "<One> hello" {
+			t.Errorf("%s got %q, want %q", b.Username, msg, "<One> hello")
+		}
+	}
+
+	// The grass block under the bot
+	below := data.Position{X: 1, Y: 63, Z: 0}
+	if err := one.Dig(below, 1); err != nil {
+		t.Fatal(err)
+	}
+	if got := receive(t, breaks, "the block break"); got != below {
+		t.Errorf("server saw a break at %v, want %v", got, below)
+	}
+	air := block.Air.Block().Default().ID
+	for _, b := range []*bot.Bot{one, two} {
+		eventually(t, b.Username+" to see the block broken", func() bool {
+			state, ok := b.Block(below)
+			return ok && block.StateID(state) == air
+		})
+	}
+
+	// Out of reach, so the block is sent back unchanged
+	far := data.Position{X: 1, Y: 63, Z: 20}
+	if err := one.Dig(far, 1); err != nil {
+		t.Fatal(err)
+	}
+	grass := block.GrassBlock.Block().Default().ID
+	eventually(t, "the out of reach block to be resent", func() bool {
+		state, ok := one.Block(far)
+		return ok && block.StateID(state) == grass
+	})
+	select {
+	case pos := <-breaks:
+		t.Errorf("server let the bot break %v, out of reach", pos)
+	default:
+	}
+}
+
+func TestQuitRemovesPlayer(t *testing.T) {
+	s := startServer(t)
+	one := join(t, s, "One", constants.ProtocolLatest)
+	two := join(t, s, "Two", constants.ProtocolLatest)
+	eventually(t, "One to see Two", func() bool {
+		_, ok := one.PlayerList()[two.UUID]
+		return ok
+	})
+
+	two.Close()
+	eventually(t, "Two to leave One's player list", func() bool {
+		_, ok := one.PlayerList()[two.UUID]
+		return !ok
+	})
+}
+
+func TestInvalidPacketDisconnects(t *testing.T) {
+	s := startServer(t)
+	b := join(t, s, "One", constants.ProtocolLatest)
+
+	// Longer than the 256 characters allowed, which strict decoding rejects
+	if err := b.Chat(strings.Repeat("a", 257)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-b.Done():
+	case <-time.After(timeout):
+		t.Fatal("the bot wasn't disconnected")
+	}
+	reason := b.DisconnectReason()
+	if reason == nil || !strings.HasPrefix(reason.PlainText(), "Invalid packet 0x06: ") || !strings.Contains(reason.PlainText(), "string is too long") {
+		t.Errorf("got disconnect reason %v, want the invalid chat message", reason)
+	}
+}
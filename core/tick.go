@@ -0,0 +1,120 @@
+package core
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	TicksPerSecond = 20
+	TickDuration   = time.Second / TicksPerSecond
+
+	// If the loop falls further behind than this, it skips the missed ticks
+	// instead of running them back to back.
+	maxTickLag = 2 * time.Second
+
+	tickSampleCount  = 100
+	tickReportPeriod = time.Minute
+)
+
+// Rolling tick timings used for MSPT/TPS.
+type tickStats struct {
+	mu        sync.Mutex
+	durations [tickSampleCount]time.Duration
+	starts    [tickSampleCount]time.Time
+	count     int
+	next      int
+}
+
+func (ts *tickStats) record(start time.Time, duration time.Duration) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.durations[ts.next] = duration
+	ts.starts[ts.next] = start
+	ts.next = (ts.next + 1) % tickSampleCount
+	ts.count = min(ts.count+1, tickSampleCount)
+}
+
+func (ts *tickStats) mspt() float64 {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.count == 0 {
+		return 0
+	}
+	var total time.Duration
+	for i := 0; i < ts.count; i++ {
+		total += ts.durations[i]
+	}
+	return float64(total) / float64(ts.count) / float64(time.Millisecond)
+}
+
+func (ts *tickStats) tps() float64 {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.count < 2 {
+		return TicksPerSecond
+	}
+	newest := ts.starts[(ts.next+tickSampleCount-1)%tickSampleCount]
+	oldest := ts.starts[(ts.next+tickSampleCount-ts.count)%tickSampleCount]
+	elapsed := newest.Sub(oldest).Seconds()
+	if elapsed <= 0 {
+		return TicksPerSecond
+	}
+	return min(TicksPerSecond, float64(ts.count-1)/elapsed)
+}
+
+// Average milliseconds spent per tick over the last 100 ticks.
+func (s *Server) MSPT() float64 {
+	return s.tickStats.mspt()
+}
+
+// Ticks per second over the last 100 ticks, at most 20.
+func (s *Server) TPS() float64 {
+	return s.tickStats.tps()
+}
+
+func (s *Server) tick() {
+	s.Scheduler.RunTick()
+	s.world.Tick()
+	s.flushClients()
+}
+
+// Runs ticks at a fixed rate until stop is closed. Ticks that overrun are
+// caught up by starting the next one immediately.
+func (s *Server) runTickLoop(stop <-chan struct{}) {
+	next := time.Now()
+	lastReport := next
+
+	for {
+		now := time.Now()
+		if wait := next.Sub(now); wait > 0 {
+			select {
+			case <-stop:
+				return
+			case <-time.After(wait):
+			}
+			now = time.Now()
+		} else {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+		}
+
+		if behind := now.Sub(next); behind > maxTickLag {
+			log.Printf("Can't keep up! Running %dms or %d ticks behind", behind.Milliseconds(), behind/TickDuration)
+			next = now
+		}
+
+		s.tick()
+		s.tickStats.record(now, time.Since(now))
+		next = next.Add(TickDuration)
+
+		if now.Sub(lastReport) >= tickReportPeriod {
+			log.Printf("TPS: %.1f, MSPT: %.2f", s.TPS(), s.MSPT())
+			lastReport = now
+		}
+	}
+}
@@ -3,15 +3,30 @@ package data
 import (
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 
+	"github.com/brenfwd/gocraft/constants"
 	"github.com/google/uuid"
 )
 
 type Buffer struct {
 	Raw []byte
+	// Protocol version of the data, which decides the fields read and written by
+	// ReadFields and WriteFields. Zero means the latest.
+	Protocol constants.ProtocolVersion
+	// Enforces maximum lengths when reading: `maxlen` tags and the protocol's
+	// default limits. Used for data from untrusted clients.
+	Strict bool
 }
 
+// Limits applied to strings in strict mode without a maxlen tag, in UTF-16
+// code units like vanilla.
+const (
+	DefaultMaxStringLength = 32767
+	MaxChatLength          = 262144
+)
+
 func NewBufferFromBytes(bytes []byte) Buffer {
 	return Buffer{Raw: bytes}
 }
@@ -30,7 +45,7 @@ func (buf *Buffer) Write(data []byte) {
 
 func (buf *Buffer) Read(length int) ([]byte, error) {
 	if len(buf.Raw) < length {
-		return nil, errors.New("buffer is too short in Read")
+		return nil, fmt.Errorf("%w in Read", ErrBufferTooShort)
 	}
 	data := buf.Raw[:length]
 	buf.Raw = buf.Raw[length:]
@@ -39,7 +54,7 @@ func (buf *Buffer) Read(length int) ([]byte, error) {
 
 func (buf *Buffer) ReadByte() (byte, error) {
 	if len(buf.Raw) == 0 {
-		return 0, errors.New("bufer is empty in ReadByte")
+		return 0, fmt.Errorf("%w in ReadByte", ErrBufferTooShort)
 	}
 	first := buf.Raw[0]
 	buf.Raw = buf.Raw[1:]
@@ -84,7 +99,7 @@ func (buf *Buffer) WriteUByte(v uint8) {
 
 func (buf *Buffer) ReadShort() (int16, error) {
 	if len(buf.Raw) < 2 {
-		return 0, errors.New("buffer is too short in ReadShort")
+		return 0, fmt.Errorf("%w in ReadShort", ErrBufferTooShort)
 	}
 	v := int16(buf.Raw[0])<<8 | int16(buf.Raw[1])
 	buf.Raw = buf.Raw[2:]
@@ -107,7 +122,7 @@ func (buf *Buffer) WriteUShort(v uint16) {
 
 func (buf *Buffer) ReadInt() (int32, error) {
 	if len(buf.Raw) < 4 {
-		return 0, errors.New("buffer is too short in ReadInt")
+		return 0, fmt.Errorf("%w in ReadInt", ErrBufferTooShort)
 	}
 	v := int32(buf.Raw[0])<<24 | int32(buf.Raw[1])<<16 | int32(buf.Raw[2])<<8 | int32(buf.Raw[3])
 	buf.Raw = buf.Raw[4:]
@@ -132,7 +147,7 @@ func (buf *Buffer) WriteUInt(v uint32) {
 
 func (buf *Buffer) ReadLong() (int64, error) {
 	if len(buf.Raw) < 8 {
-		return 0, errors.New("buffer is too short in ReadLong")
+		return 0, fmt.Errorf("%w in ReadLong", ErrBufferTooShort)
 	}
 	v := int64(buf.Raw[0])<<56 | int64(buf.Raw[1])<<48 | int64(buf.Raw[2])<<40 | int64(buf.Raw[3])<<32 | int64(buf.Raw[4])<<24 | int64(buf.Raw[5])<<16 | int64(buf.Raw[6])<<8 | int64(buf.Raw[7])
 	buf.Raw = buf.Raw[8:]
@@ -161,20 +176,20 @@ func (buf *Buffer) WriteULong(v uint64) {
 
 func (buf *Buffer) ReadFloat() (float32, error) {
 	v, err := buf.ReadUInt()
-	return float32(v), err
+	return math.Float32frombits(v), err
 }
 
 func (buf *Buffer) WriteFloat(v float32) {
-	buf.WriteUInt(uint32(v))
+	buf.WriteUInt(math.Float32bits(v))
 }
 
 func (buf *Buffer) ReadDouble() (float64, error) {
 	v, err := buf.ReadULong()
-	return float64(v), err
+	return math.Float64frombits(v), err
 }
 
 func (buf *Buffer) WriteDouble(v float64) {
-	buf.WriteULong(uint64(v))
+	buf.WriteULong(math.Float64bits(v))
 }
 
 func (buf *Buffer) ReadVarInt() (value VarInt, bytes int, err error) {
@@ -262,14 +277,14 @@ func (buf *Buffer) ReadString() (value string, bytes int, err error) {
 		return
 	}
 	if length < 0 {
-		err = errors.New("string length is negative")
+		err = fmt.Errorf("%w: string length %d is negative", ErrInvalidLength, length)
 		return
 	}
 	if length == 0 {
 		return "", bytes, nil
 	}
 	if length > VarInt(len(buf.Raw)) {
-		err = errors.New("string length is too big")
+		err = fmt.Errorf("%w: string length %d exceeds the remaining %d bytes", ErrBufferTooShort, length, len(buf.Raw))
 		return
 	}
 	value = string(buf.Raw[:length])
@@ -278,6 +293,37 @@ func (buf *Buffer) ReadString() (value string, bytes int, err error) {
 	return
 }
 
+// Reads a string of at most maxLength UTF-16 code units, as checked by vanilla.
+func (buf *Buffer) ReadStringMax(maxLength int) (string, error) {
+	save := buf.Raw
+	length, _, err := buf.ReadVarInt()
+	if err != nil {
+		return "", err
+	}
+	// Each code unit takes at most 3 bytes in UTF-8
+	if int64(length) > int64(maxLength)*3 {
+		return "", fmt.Errorf("%w: %d bytes, the maximum is %d characters", ErrStringTooLong, length, maxLength)
+	}
+	buf.Raw = save
+	value, _, err := buf.ReadString()
+	if err != nil {
+		return "", err
+	}
+	units := 0
+	for _, r := range value {
+		// Characters outside the BMP are surrogate pairs
+		if r >= 0x10000 {
+			units += 2
+		} else {
+			units++
+		}
+	}
+	if units > maxLength {
+		return "", fmt.Errorf("%w: %d characters, the maximum is %d", ErrStringTooLong, units, maxLength)
+	}
+	return value, nil
+}
+
 func (buf *Buffer) WriteString(str string) (bytes int) {
 	lbytes := buf.WriteVarInt(VarInt(len(str)))
 	bytes += lbytes
@@ -300,7 +346,7 @@ func (buf *Buffer) WriteUUID(value uuid.UUID) {
 }
 
 type BufferWritable interface {
-	BufferWrite(*Buffer)
+	BufferWrite(*Buffer) error
 }
 
 type BufferReadable[T any] interface {
@@ -311,75 +357,91 @@ type BufferSliceLength string
 
 const (
 	BufferSliceLengthVarInt BufferSliceLength = "varint"
+	BufferSliceLengthByte   BufferSliceLength = "byte"
+	BufferSliceLengthShort  BufferSliceLength = "short"
+	BufferSliceLengthInt    BufferSliceLength = "int"
 )
 
 func (buf *Buffer) ReadReflectedSlice(elemType reflect.Type, lengthType BufferSliceLength) (value reflect.Value, err error) {
-	length, err := buf.readLength(lengthType)
+	c, err := valueCodecFor(elemType)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	length, err := buf.readSliceLength(lengthType, 0)
 	if err != nil {
 		return reflect.Value{}, err
 	}
-
 	slice := reflect.MakeSlice(reflect.SliceOf(elemType), length, length)
 	for i := 0; i < length; i++ {
-		v, err := buf.ReadReflected(elemType)
-		if err != nil {
+		if err := c.read(buf, slice.Index(i)); err != nil {
 			return reflect.Value{}, err
 		}
-		slice.Index(i).Set(v)
 	}
 	return slice, nil
 }
 
-func (buf *Buffer) ReadReflected(t reflect.Type) (value reflect.Value, err error) {
-	// Check if value implements BufferReadable[T] for some T...
-	if t.Implements(reflect.TypeFor[BufferReadable[any]]()) {
-		readMethod := reflect.ValueOf(value).MethodByName("BufferRead")
-		if !readMethod.IsValid() {
-			return reflect.Value{}, fmt.Errorf("BufferReadable type %v does not have a BufferRead method", t)
-		}
-		args := []reflect.Value{reflect.ValueOf(buf)}
-		results := readMethod.Call(args)
-		if len(results) != 2 {
-			return reflect.Value{}, fmt.Errorf("BufferReadable type %v BufferRead method did not return 2 values", t)
-		}
-		if !results[1].IsNil() {
-			return reflect.Value{}, results[1].Interface().(error)
-		}
-		return results[0], nil
-	}
-
-	if t.Kind() == reflect.Slice {
-		return buf.ReadReflectedSlice(t, BufferSliceLengthVarInt)
-	}
-	var v any
-	switch t {
-	case reflect.TypeFor[VarInt]():
-		v, _, err = buf.ReadVarInt()
-	case reflect.TypeFor[VarLong]():
-		v, _, err = buf.ReadVarLong()
-	case reflect.TypeFor[uuid.UUID]():
-		v, err = buf.ReadUUID()
-	case reflect.TypeFor[string]():
-		v, _, err = buf.ReadString()
-	case reflect.TypeFor[bool]():
-		v, err = buf.ReadBoolean()
-	case reflect.TypeFor[byte]():
-		v, err = buf.ReadByte()
-	case reflect.TypeFor[uint16]():
-		v, err = buf.ReadUShort()
-	case reflect.TypeFor[int32]():
-		v, err = buf.ReadInt()
-	case reflect.TypeFor[int]():
-		v, err = buf.ReadInt()
-	case reflect.TypeFor[int64]():
-		v, err = buf.ReadLong()
-	default:
-		err = fmt.Errorf("unhandled type %v with kind %v", t, t.Kind())
+// Reads the length of a slice of at most maxLength elements, if maxLength is
+// positive and the buffer is strict.
+func (buf *Buffer) readSliceLength(lengthType BufferSliceLength, maxLength int) (int, error) {
+	length, err := buf.readLength(lengthType)
+	if err != nil {
+		return 0, err
+	}
+	if length < 0 {
+		return 0, fmt.Errorf("%w: array length %d is negative", ErrInvalidLength, length)
 	}
+	if buf.Strict && maxLength > 0 && length > maxLength {
+		return 0, fmt.Errorf("%w: %d elements, the maximum is %d", ErrArrayTooLong, length, maxLength)
+	}
+	// Every element takes at least one byte, which keeps a bogus length from
+	// allocating a huge slice.
+	if length > buf.Length() {
+		return 0, fmt.Errorf("%w: array length %d exceeds the remaining %d bytes", ErrBufferTooShort, length, buf.Length())
+	}
+	return length, nil
+}
+
+func (buf *Buffer) ReadReflected(t reflect.Type) (value reflect.Value, err error) {
+	c, err := valueCodecFor(t)
 	if err != nil {
 		return reflect.Value{}, err
 	}
-	return reflect.ValueOf(v), nil
+	v := reflect.New(t).Elem()
+	if err := c.read(buf, v); err != nil {
+		return reflect.Value{}, err
+	}
+	return v, nil
+}
+
+// Reads a string, limited to maxLength in strict mode.
+func (buf *Buffer) readString(maxLength int) (string, error) {
+	if buf.Strict {
+		return buf.ReadStringMax(maxLength)
+	}
+	s, _, err := buf.ReadString()
+	return s, err
+}
+
+// Finds the BufferRead method of types implementing BufferReadable[T], with
+// T being the type itself (e.g. func (*NBTValue) BufferRead(*Buffer)
+// (*NBTValue, error)). The method is bound to a zero receiver: a nil pointer
+// for pointer types, otherwise a pointer to a new value.
+func bufferReadMethod(t reflect.Type) (reflect.Value, bool) {
+	receiver := reflect.Zero(t)
+	method, ok := t.MethodByName("BufferRead")
+	if !ok && t.Kind() != reflect.Pointer {
+		receiver = reflect.New(t)
+		method, ok = receiver.Type().MethodByName("BufferRead")
+	}
+	if !ok {
+		return reflect.Value{}, false
+	}
+	mt := method.Type
+	if mt.NumIn() != 2 || mt.In(1) != reflect.TypeFor[*Buffer]() ||
+		mt.NumOut() != 2 || mt.Out(0) != t || mt.Out(1) != reflect.TypeFor[error]() {
+		return reflect.Value{}, false
+	}
+	return receiver.Method(method.Index), true
 }
 
 func (buf *Buffer) readLength(lengthType BufferSliceLength) (int, error) {
@@ -387,6 +449,15 @@ func (buf *Buffer) readLength(lengthType BufferSliceLength) (int, error) {
 	case BufferSliceLengthVarInt:
 		v, _, err := buf.ReadVarInt()
 		return int(v), err
+	case BufferSliceLengthByte:
+		v, err := buf.ReadUByte()
+		return int(v), err
+	case BufferSliceLengthShort:
+		v, err := buf.ReadShort()
+		return int(v), err
+	case BufferSliceLengthInt:
+		v, err := buf.ReadInt()
+		return int(v), err
 	default:
 		return 0, fmt.Errorf("unhandled length type for ReadSlice: %v", lengthType)
 	}
@@ -397,15 +468,17 @@ func (buf *Buffer) WriteSlice(value any, lengthType BufferSliceLength) (err erro
 	if t.Kind() != reflect.Slice {
 		return fmt.Errorf("value passed to WriteSlice is not a slice: %v", t.Kind())
 	}
+	c, err := valueCodecFor(t.Elem())
+	if err != nil {
+		return err
+	}
 
 	reflected := reflect.ValueOf(value)
 	if err := buf.writeLength(lengthType, reflected.Len()); err != nil {
 		return err
 	}
-
 	for i := 0; i < reflected.Len(); i++ {
-		v := reflected.Index(i).Interface()
-		if err := buf.WriteAny(v); err != nil {
+		if err := c.write(buf, reflected.Index(i)); err != nil {
 			return err
 		}
 	}
@@ -417,6 +490,21 @@ func (buf *Buffer) writeLength(lengthType BufferSliceLength, length int) error {
 	case BufferSliceLengthVarInt:
 		buf.WriteVarInt(VarInt(length))
 		return nil
+	case BufferSliceLengthByte:
+		if length > math.MaxUint8 {
+			return fmt.Errorf("%w: %d elements don't fit a byte length", ErrArrayTooLong, length)
+		}
+		buf.WriteUByte(uint8(length))
+		return nil
+	case BufferSliceLengthShort:
+		if length > math.MaxInt16 {
+			return fmt.Errorf("%w: %d elements don't fit a short length", ErrArrayTooLong, length)
+		}
+		buf.WriteShort(int16(length))
+		return nil
+	case BufferSliceLengthInt:
+		buf.WriteInt(int32(length))
+		return nil
 	default:
 		return fmt.Errorf("unhandled length type for WriteSlice: %v", lengthType)
 	}
@@ -424,49 +512,16 @@ func (buf *Buffer) writeLength(lengthType BufferSliceLength, length int) error {
 
 func (buf *Buffer) WriteAny(value any) error {
 	if writable, ok := value.(BufferWritable); ok {
-		writable.BufferWrite(buf)
-		return nil
+		return writable.BufferWrite(buf)
 	}
 
-	switch v := value.(type) {
-	case VarInt:
-		buf.WriteVarInt(v)
-		return nil
-	case VarLong:
-		buf.WriteVarLong(v)
-		return nil
-	case Chat:
-		s, err := v.String()
-		if err != nil {
-			return err
-		}
-		buf.WriteString(s)
-		return nil
-	case uuid.UUID:
-		buf.WriteUUID(v)
-		return nil
-	case string:
-		buf.WriteString(v)
-		return nil
-	case bool:
-		buf.WriteBoolean(v)
-		return nil
-	case byte:
-		buf.Push(v)
-		return nil
-	case uint16:
-		buf.WriteUShort(v)
-		return nil
-	case int32:
-		buf.WriteInt(v)
-		return nil
-	case int:
-		buf.WriteInt(int32(v))
-		return nil
-	case int64:
-		buf.WriteLong(v)
-		return nil
-	default:
-		return fmt.Errorf("unhandled type for WriteAny: %T", value)
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() == reflect.Pointer && !reflected.IsNil() && reflected.Elem().Kind() == reflect.Struct {
+		reflected = reflected.Elem()
+	}
+	c, err := valueCodecFor(reflected.Type())
+	if err != nil {
+		return err
 	}
+	return c.write(buf, reflected)
 }
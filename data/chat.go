@@ -130,6 +130,79 @@ func (c *Chat) ToNBT(rootCompoundName *string) *NBTValue {
 	return root
 }
 
+// Parses a chat component sent as JSON, which can also be a plain string.
+func ParseChat(s string) (*Chat, error) {
+	c := MakeChat()
+	if len(s) > 0 && s[0] == '"' {
+		var text string
+		if err := json.Unmarshal([]byte(s), &text); err != nil {
+			return nil, err
+		}
+		return c.SetText(text), nil
+	}
+	if err := json.Unmarshal([]byte(s), c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// The counterpart of ToNBT. Components can also be a plain string tag.
+// Properties that ToNBT doesn't write are ignored.
+func ChatFromNBT(v *NBTValue) *Chat {
+	c := MakeChat()
+	if text, ok := v.Value.(string); ok {
+		return c.SetText(text)
+	}
+	stringEntry := func(name string) (string, bool) {
+		if entry := v.Get(name); entry != nil {
+			s, ok := entry.Value.(string)
+			return s, ok
+		}
+		return "", false
+	}
+	boolEntry := func(name string) bool {
+		if entry := v.Get(name); entry != nil {
+			b, ok := entry.Value.(byte)
+			return ok && b != 0
+		}
+		return false
+	}
+
+	if text, ok := stringEntry("text"); ok {
+		c.SetText(text)
+	}
+	if color, ok := stringEntry("color"); ok {
+		c.SetColor(ChatColor(color))
+	}
+	if font, ok := stringEntry("font"); ok {
+		c.SetFont(ChatFont(font))
+	}
+	c.Bold = boolEntry("bold")
+	c.Italic = boolEntry("italic")
+	c.Underlined = boolEntry("underlined")
+	c.Strikethrough = boolEntry("strikethrough")
+	c.Obfuscated = boolEntry("obfuscated")
+	if extra := v.Get("extra"); extra != nil {
+		entries, _ := extra.Value.([]*NBTValue)
+		for _, entry := range entries {
+			c.AddExtra(ChatFromNBT(entry))
+		}
+	}
+	return c
+}
+
+// The text of the component and its children without formatting.
+func (c *Chat) PlainText() string {
+	var text string
+	if c.Text != nil {
+		text = *c.Text
+	}
+	for _, extra := range c.Extra {
+		text += extra.PlainText()
+	}
+	return text
+}
+
 func (c *Chat) SetText(value string) *Chat {
 	c.Text = &value
 	return c
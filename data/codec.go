@@ -0,0 +1,607 @@
+package data
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Reads and writes values of one type. Codecs are compiled once per type, so
+// that tags are parsed and types switched on only the first time a type is
+// encoded, instead of for every value.
+type codec struct {
+	// Reads into v, which is addressable
+	read  func(buf *Buffer, v reflect.Value) error
+	write func(buf *Buffer, v reflect.Value) error
+}
+
+type structCodec struct {
+	t      reflect.Type
+	fields []fieldCodec
+}
+
+type fieldCodec struct {
+	index int
+	name  string
+	opts  fieldOptions
+	codec
+}
+
+var (
+	// Compiled codecs by type: *structCodec for ReadFields and WriteFields, and
+	// *codec for ReadReflected and WriteAny
+	structCodecs sync.Map
+	valueCodecs  sync.Map
+
+	compileMu sync.Mutex
+	// Struct codecs being compiled, for recursive types. Guarded by compileMu.
+	compiling = make(map[reflect.Type]*structCodec)
+)
+
+// Compiles the codec of struct type t ahead of time, checking its tags. Codecs
+// are otherwise compiled the first time a type is read or written.
+func Compile(t reflect.Type) error {
+	_, err := structCodecFor(t)
+	return err
+}
+
+func structCodecFor(t reflect.Type) (*structCodec, error) {
+	if sc, ok := structCodecs.Load(t); ok {
+		return sc.(*structCodec), nil
+	}
+	compileMu.Lock()
+	defer compileMu.Unlock()
+	return compileStruct(t)
+}
+
+func valueCodecFor(t reflect.Type) (*codec, error) {
+	if c, ok := valueCodecs.Load(t); ok {
+		return c.(*codec), nil
+	}
+	compileMu.Lock()
+	defer compileMu.Unlock()
+	c, err := compileType(t, BufferSliceLengthVarInt, 0)
+	if err != nil {
+		return nil, err
+	}
+	valueCodecs.Store(t, &c)
+	return &c, nil
+}
+
+// Must be called with compileMu held.
+func compileStruct(t reflect.Type) (*structCodec, error) {
+	if sc, ok := structCodecs.Load(t); ok {
+		return sc.(*structCodec), nil
+	}
+	if sc, ok := compiling[t]; ok {
+		return sc, nil
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%v is not a struct", t)
+	}
+
+	sc := &structCodec{t: t}
+	compiling[t] = sc
+	defer delete(compiling, t)
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if f.Anonymous {
+			continue
+		}
+		opts, err := parseFieldOptions(t, f)
+		if err != nil {
+			return nil, err
+		}
+		c, err := compileField(f.Type, &opts)
+		if err != nil {
+			return nil, fmt.Errorf("%v field %v: %w", t, f.Name, err)
+		}
+		sc.fields = append(sc.fields, fieldCodec{index: i, name: f.Name, opts: opts, codec: c})
+	}
+	structCodecs.Store(t, sc)
+	return sc, nil
+}
+
+func (sc *structCodec) read(buf *Buffer, target reflect.Value) error {
+	protocol := buf.protocol()
+	for i := range sc.fields {
+		f := &sc.fields[i]
+		if !f.opts.present(protocol) || (f.opts.cond != nil && !f.opts.cond.holds(target)) {
+			continue
+		}
+		if err := f.read(buf, target.Field(f.index)); err != nil {
+			return &FieldError{Type: sc.t, Field: f.name, Err: err}
+		}
+	}
+	return nil
+}
+
+func (sc *structCodec) write(buf *Buffer, v reflect.Value) error {
+	protocol := buf.protocol()
+	for i := range sc.fields {
+		f := &sc.fields[i]
+		if !f.opts.present(protocol) || (f.opts.cond != nil && !f.opts.cond.holds(v)) {
+			continue
+		}
+		if err := f.write(buf, v.Field(f.index)); err != nil {
+			return &FieldError{Type: sc.t, Field: f.name, Err: err}
+		}
+	}
+	return nil
+}
+
+// Compiles the codec of a struct field, applying its options.
+func compileField(t reflect.Type, opts *fieldOptions) (codec, error) {
+	switch {
+	case opts.optional:
+		inner := *opts
+		inner.optional = false
+		elem := valueType(t, true)
+		c, err := compileField(elem, &inner)
+		if err != nil {
+			return codec{}, err
+		}
+		// Pointer types that read themselves (e.g. *NBTValue) are used as is
+		direct := elem == t
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				present, err := buf.ReadBoolean()
+				if err != nil || !present {
+					v.SetZero()
+					return err
+				}
+				if direct {
+					return c.read(buf, v)
+				}
+				p := reflect.New(elem)
+				if err := c.read(buf, p.Elem()); err != nil {
+					return err
+				}
+				v.Set(p)
+				return nil
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteBoolean(!v.IsNil())
+				if v.IsNil() {
+					return nil
+				}
+				if !direct {
+					v = v.Elem()
+				}
+				return c.write(buf, v)
+			},
+		}, nil
+	case opts.rest:
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				rest, _ := buf.Read(buf.Length())
+				v.SetBytes(append([]byte(nil), rest...))
+				return nil
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.Write(v.Bytes())
+				return nil
+			},
+		}, nil
+	case opts.varint:
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				n, _, err := buf.ReadVarInt()
+				if v.CanInt() {
+					v.SetInt(int64(n))
+				} else {
+					v.SetUint(uint64(uint32(n)))
+				}
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				if v.CanInt() {
+					buf.WriteVarInt(VarInt(v.Int()))
+				} else {
+					buf.WriteVarInt(VarInt(v.Uint()))
+				}
+				return nil
+			},
+		}, nil
+	default:
+		length := opts.length
+		if length == "" {
+			length = BufferSliceLengthVarInt
+		}
+		return compileType(t, length, opts.maxLen)
+	}
+}
+
+// Compiles the codec of a type. length and maxLength apply to slices, and
+// maxLength to strings. Must be called with compileMu held.
+func compileType(t reflect.Type, length BufferSliceLength, maxLength int) (codec, error) {
+	c, err := compileGeneric(t, length, maxLength)
+
+	// Types with their own BufferRead and BufferWrite methods override the
+	// generic encoding
+	custom := false
+	if read, ok := bufferReadMethod(t); ok {
+		custom = true
+		c.read = func(buf *Buffer, v reflect.Value) error {
+			results := read.Call([]reflect.Value{reflect.ValueOf(buf)})
+			if err, _ := results[1].Interface().(error); err != nil {
+				return err
+			}
+			v.Set(results[0])
+			return nil
+		}
+	}
+	writable := reflect.TypeFor[BufferWritable]()
+	if t.Implements(writable) {
+		custom = true
+		c.write = func(buf *Buffer, v reflect.Value) error {
+			return v.Interface().(BufferWritable).BufferWrite(buf)
+		}
+	} else if reflect.PointerTo(t).Implements(writable) {
+		custom = true
+		c.write = func(buf *Buffer, v reflect.Value) error {
+			return addressOf(v).Interface().(BufferWritable).BufferWrite(buf)
+		}
+	}
+	if err != nil && !custom {
+		return codec{}, err
+	}
+
+	// Types with only one custom method don't need the generic encoding to
+	// exist, as long as the other direction is never used
+	if c.read == nil {
+		c.read = func(*Buffer, reflect.Value) error {
+			return fmt.Errorf("unhandled type %v with kind %v", t, t.Kind())
+		}
+	}
+	if c.write == nil {
+		c.write = func(*Buffer, reflect.Value) error {
+			return fmt.Errorf("unhandled type %v with kind %v", t, t.Kind())
+		}
+	}
+	return c, nil
+}
+
+func compileGeneric(t reflect.Type, length BufferSliceLength, maxLength int) (codec, error) {
+	switch t {
+	case reflect.TypeFor[VarInt]():
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				n, _, err := buf.ReadVarInt()
+				v.SetInt(int64(n))
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteVarInt(VarInt(v.Int()))
+				return nil
+			},
+		}, nil
+	case reflect.TypeFor[VarLong]():
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				n, _, err := buf.ReadVarLong()
+				v.SetInt(int64(n))
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteVarLong(VarLong(v.Int()))
+				return nil
+			},
+		}, nil
+	case reflect.TypeFor[Position]():
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				packed, err := buf.ReadLong()
+				*v.Addr().Interface().(*Position) = UnpackPosition(packed)
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteLong(addressOf(v).Interface().(*Position).Pack())
+				return nil
+			},
+		}, nil
+	case reflect.TypeFor[Chat]():
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				s, err := buf.readString(MaxChatLength)
+				if err != nil {
+					return err
+				}
+				c, err := ParseChat(s)
+				if err != nil {
+					return err
+				}
+				*v.Addr().Interface().(*Chat) = *c
+				return nil
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				s, err := addressOf(v).Interface().(*Chat).String()
+				if err != nil {
+					return err
+				}
+				buf.WriteString(s)
+				return nil
+			},
+		}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				b, err := buf.ReadBoolean()
+				v.SetBool(b)
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteBoolean(v.Bool())
+				return nil
+			},
+		}, nil
+	case reflect.Uint8:
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				b, err := buf.ReadByte()
+				v.SetUint(uint64(b))
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.Push(byte(v.Uint()))
+				return nil
+			},
+		}, nil
+	case reflect.Int8:
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				b, err := buf.ReadByte()
+				v.SetInt(int64(int8(b)))
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.Push(byte(v.Int()))
+				return nil
+			},
+		}, nil
+	case reflect.Int16:
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				n, err := buf.ReadShort()
+				v.SetInt(int64(n))
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteShort(int16(v.Int()))
+				return nil
+			},
+		}, nil
+	case reflect.Uint16:
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				n, err := buf.ReadUShort()
+				v.SetUint(uint64(n))
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteUShort(uint16(v.Uint()))
+				return nil
+			},
+		}, nil
+	case reflect.Int32, reflect.Int:
+		// int is encoded as an int32
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				n, err := buf.ReadInt()
+				v.SetInt(int64(n))
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteInt(int32(v.Int()))
+				return nil
+			},
+		}, nil
+	case reflect.Uint32:
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				n, err := buf.ReadUInt()
+				v.SetUint(uint64(n))
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteUInt(uint32(v.Uint()))
+				return nil
+			},
+		}, nil
+	case reflect.Int64:
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				n, err := buf.ReadLong()
+				v.SetInt(n)
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteLong(v.Int())
+				return nil
+			},
+		}, nil
+	case reflect.Uint64:
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				n, err := buf.ReadULong()
+				v.SetUint(n)
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteULong(v.Uint())
+				return nil
+			},
+		}, nil
+	case reflect.Float32:
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				f, err := buf.ReadFloat()
+				v.SetFloat(float64(f))
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteFloat(float32(v.Float()))
+				return nil
+			},
+		}, nil
+	case reflect.Float64:
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				f, err := buf.ReadDouble()
+				v.SetFloat(f)
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteDouble(v.Float())
+				return nil
+			},
+		}, nil
+	case reflect.String:
+		if maxLength <= 0 {
+			maxLength = DefaultMaxStringLength
+		}
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				s, err := buf.readString(maxLength)
+				v.SetString(s)
+				return err
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.WriteString(v.String())
+				return nil
+			},
+		}, nil
+	case reflect.Struct:
+		sc, err := compileStruct(t)
+		if err != nil {
+			return codec{}, err
+		}
+		return codec{read: sc.read, write: sc.write}, nil
+	case reflect.Array:
+		return compileArray(t)
+	case reflect.Slice:
+		return compileSlice(t, length, maxLength)
+	default:
+		return codec{}, fmt.Errorf("unhandled type %v with kind %v", t, t.Kind())
+	}
+}
+
+// Fixed-size arrays, which have no length prefix.
+func compileArray(t reflect.Type) (codec, error) {
+	n := t.Len()
+	if t.Elem().Kind() == reflect.Uint8 {
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				bytes, err := buf.Read(n)
+				if err != nil {
+					return err
+				}
+				reflect.Copy(v, reflect.ValueOf(bytes))
+				return nil
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				buf.Write(addressOf(v).Elem().Slice(0, n).Bytes())
+				return nil
+			},
+		}, nil
+	}
+
+	elem, err := compileType(t.Elem(), BufferSliceLengthVarInt, 0)
+	if err != nil {
+		return codec{}, err
+	}
+	return codec{
+		read: func(buf *Buffer, v reflect.Value) error {
+			for i := 0; i < n; i++ {
+				if err := elem.read(buf, v.Index(i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		write: func(buf *Buffer, v reflect.Value) error {
+			for i := 0; i < n; i++ {
+				if err := elem.write(buf, v.Index(i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}, nil
+}
+
+func compileSlice(t reflect.Type, length BufferSliceLength, maxLength int) (codec, error) {
+	switch length {
+	case BufferSliceLengthVarInt, BufferSliceLengthByte, BufferSliceLengthShort, BufferSliceLengthInt:
+	default:
+		return codec{}, fmt.Errorf("unhandled length type %q", length)
+	}
+
+	if t.Elem().Kind() == reflect.Uint8 {
+		return codec{
+			read: func(buf *Buffer, v reflect.Value) error {
+				n, err := buf.readSliceLength(length, maxLength)
+				if err != nil {
+					return err
+				}
+				bytes, _ := buf.Read(n)
+				s := reflect.MakeSlice(t, n, n)
+				reflect.Copy(s, reflect.ValueOf(bytes))
+				v.Set(s)
+				return nil
+			},
+			write: func(buf *Buffer, v reflect.Value) error {
+				if err := buf.writeLength(length, v.Len()); err != nil {
+					return err
+				}
+				buf.Write(v.Bytes())
+				return nil
+			},
+		}, nil
+	}
+
+	elem, err := compileType(t.Elem(), BufferSliceLengthVarInt, 0)
+	if err != nil {
+		return codec{}, err
+	}
+	return codec{
+		read: func(buf *Buffer, v reflect.Value) error {
+			n, err := buf.readSliceLength(length, maxLength)
+			if err != nil {
+				return err
+			}
+			s := reflect.MakeSlice(t, n, n)
+			for i := 0; i < n; i++ {
+				if err := elem.read(buf, s.Index(i)); err != nil {
+					return err
+				}
+			}
+			v.Set(s)
+			return nil
+		},
+		write: func(buf *Buffer, v reflect.Value) error {
+			if err := buf.writeLength(length, v.Len()); err != nil {
+				return err
+			}
+			for i := 0; i < v.Len(); i++ {
+				if err := elem.write(buf, v.Index(i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}, nil
+}
+
+// Returns a pointer to v, copying v if it isn't addressable.
+func addressOf(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v.Addr()
+	}
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	return p
+}
@@ -2,3 +2,14 @@ package data
 
 type VarInt int32
 type VarLong int32
+
+// Rotation in steps of 1/256 of a full turn.
+type Angle byte
+
+func AngleFromDegrees(deg float32) Angle {
+	return Angle(int32(deg * 256 / 360))
+}
+
+func (a Angle) Degrees() float32 {
+	return float32(a) * 360 / 256
+}
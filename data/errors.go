@@ -0,0 +1,32 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Errors for invalid data, wrapped with details. Use errors.Is to check for
+// them.
+var (
+	ErrBufferTooShort = errors.New("buffer is too short")
+	ErrInvalidLength  = errors.New("invalid length")
+	ErrStringTooLong  = errors.New("string is too long")
+	ErrArrayTooLong   = errors.New("array is too long")
+	ErrTrailingBytes  = errors.New("trailing bytes")
+)
+
+// An error reading or writing a struct field, see ReadFields.
+type FieldError struct {
+	Type  reflect.Type
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%v.%s: %v", e.Type, e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
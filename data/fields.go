@@ -0,0 +1,212 @@
+package data
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/brenfwd/gocraft/constants"
+)
+
+// Struct fields are encoded in declaration order. Anonymous (embedded) fields
+// are skipped, they are used as markers (e.g. messages.Serverbound).
+//
+// Fields are configured with a comma-separated `message` tag:
+//
+//	length:<ltype>  how the length of a slice is encoded (required for slices):
+//	                varint, byte, short or int
+//	rest            a []byte holding everything up to the end of the buffer,
+//	                instead of a length
+//	maxlen:<n>      maximum length of a string (in characters) or slice, checked
+//	                when reading in strict mode
+//	optional        a pointer field prefixed with a boolean telling whether it
+//	                is present (nil if not). Other options apply to the value.
+//	varint          an integer field encoded as a VarInt, for enums
+//	if:<field>      the field only exists if the earlier field is true (or
+//	                non-zero)
+//	if:<field>=<n>  the field only exists if the earlier integer field is n
+//	since:<version> the field only exists from this protocol version on
+//	until:<version> the field only exists up to this protocol version
+//
+// Go arrays are fixed-size and have no length prefix, e.g. [256]byte.
+
+type fieldOptions struct {
+	length   BufferSliceLength
+	rest     bool
+	maxLen   int
+	optional bool
+	varint   bool
+	cond     *fieldCondition
+	since    constants.ProtocolVersion
+	until    constants.ProtocolVersion
+}
+
+type fieldCondition struct {
+	field    int
+	value    int64
+	hasValue bool
+}
+
+func parseFieldOptions(t reflect.Type, f reflect.StructField) (fieldOptions, error) {
+	var opts fieldOptions
+	tag, ok := f.Tag.Lookup("message")
+	if ok {
+		for _, opt := range strings.Split(tag, ",") {
+			key, value, _ := strings.Cut(opt, ":")
+			switch key {
+			case "length":
+				opts.length = BufferSliceLength(value)
+			case "rest":
+				if valueType(f.Type, opts.optional) != reflect.TypeFor[[]byte]() {
+					return opts, fmt.Errorf("%v field %v has the rest option but is not a []byte", t, f.Name)
+				}
+				opts.rest = true
+			case "maxlen":
+				n, err := strconv.Atoi(value)
+				if err != nil || n <= 0 {
+					return opts, fmt.Errorf("%v field %v has invalid maxlen %q", t, f.Name, value)
+				}
+				opts.maxLen = n
+			case "optional":
+				if f.Type.Kind() != reflect.Pointer {
+					return opts, fmt.Errorf("%v field %v has the optional option but is not a pointer", t, f.Name)
+				}
+				opts.optional = true
+			case "varint":
+				if !isInteger(valueType(f.Type, opts.optional)) {
+					return opts, fmt.Errorf("%v field %v has the varint option but is not an integer", t, f.Name)
+				}
+				opts.varint = true
+			case "if":
+				cond, err := parseFieldCondition(t, f, value)
+				if err != nil {
+					return opts, err
+				}
+				opts.cond = cond
+			case "since", "until":
+				v, err := strconv.ParseInt(value, 10, 32)
+				if err != nil {
+					return opts, fmt.Errorf("%v field %v has invalid %s version %q", t, f.Name, key, value)
+				}
+				if key == "since" {
+					opts.since = constants.ProtocolVersion(v)
+				} else {
+					opts.until = constants.ProtocolVersion(v)
+				}
+			default:
+				return opts, fmt.Errorf("%v field %v tag has unknown option %q", t, f.Name, opt)
+			}
+		}
+	}
+	if valueType(f.Type, opts.optional).Kind() == reflect.Slice && opts.length == "" && !opts.rest {
+		return opts, fmt.Errorf("%v field %v is a slice type but is missing a `message:\"length...\" tag", t, f.Name)
+	}
+	return opts, nil
+}
+
+func parseFieldCondition(t reflect.Type, f reflect.StructField, value string) (*fieldCondition, error) {
+	name, expected, hasValue := strings.Cut(value, "=")
+	field, ok := t.FieldByName(name)
+	if !ok || len(field.Index) != 1 || field.Index[0] >= f.Index[0] {
+		return nil, fmt.Errorf("%v field %v depends on %q, which is not an earlier field", t, f.Name, name)
+	}
+	cond := &fieldCondition{field: field.Index[0], hasValue: hasValue}
+	if hasValue {
+		n, err := strconv.ParseInt(expected, 10, 64)
+		if err != nil || !isInteger(field.Type) {
+			return nil, fmt.Errorf("%v field %v has invalid condition %q", t, f.Name, value)
+		}
+		cond.value = n
+	} else if field.Type.Kind() != reflect.Bool && !isInteger(field.Type) {
+		return nil, fmt.Errorf("%v field %v depends on %v, which is not a boolean or integer", t, f.Name, name)
+	}
+	return cond, nil
+}
+
+// Whether the condition holds for the struct v, which has its earlier fields
+// set.
+func (cond *fieldCondition) holds(v reflect.Value) bool {
+	field := v.Field(cond.field)
+	var n int64
+	switch {
+	case field.Kind() == reflect.Bool:
+		if field.Bool() {
+			n = 1
+		}
+	case field.CanInt():
+		n = field.Int()
+	default:
+		n = int64(field.Uint())
+	}
+	if cond.hasValue {
+		return n == cond.value
+	}
+	return n != 0
+}
+
+// The type of the value encoded for a field: the pointed-to type of optional
+// fields, unless the pointer type reads itself (e.g. *NBTValue).
+func valueType(t reflect.Type, optional bool) reflect.Type {
+	if !optional {
+		return t
+	}
+	if _, ok := bufferReadMethod(t); ok {
+		return t
+	}
+	return t.Elem()
+}
+
+func isInteger(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// The protocol version the buffer is encoded in. Buffers that don't set one use
+// the latest.
+func (buf *Buffer) protocol() constants.ProtocolVersion {
+	if buf.Protocol == 0 {
+		return constants.ProtocolLatest
+	}
+	return buf.Protocol
+}
+
+func (opts *fieldOptions) present(protocol constants.ProtocolVersion) bool {
+	if opts.since != 0 && protocol < opts.since {
+		return false
+	}
+	if opts.until != 0 && protocol > opts.until {
+		return false
+	}
+	return true
+}
+
+// Reads each field of the struct pointed to by target.
+func (buf *Buffer) ReadFields(target reflect.Value) error {
+	sc, err := structCodecFor(target.Type())
+	if err != nil {
+		return err
+	}
+	return sc.read(buf, target)
+}
+
+// Checks that everything has been read, in strict mode.
+func (buf *Buffer) CheckConsumed() error {
+	if buf.Strict && !buf.Empty() {
+		return fmt.Errorf("%w: %d bytes left", ErrTrailingBytes, buf.Length())
+	}
+	return nil
+}
+
+// Writes each field of the struct v.
+func (buf *Buffer) WriteFields(v reflect.Value) error {
+	sc, err := structCodecFor(v.Type())
+	if err != nil {
+		return err
+	}
+	return sc.write(buf, v)
+}
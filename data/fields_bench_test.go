@@ -0,0 +1,336 @@
+package data
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+// The reflective encoding ReadFields and WriteFields used before codecs were
+// compiled, as a baseline for the benchmarks: every call looks the fields up,
+// parses their tags and boxes each value. Only the types the benchmarks use
+// are handled.
+
+func (buf *Buffer) reflectiveReadFields(target reflect.Value) error {
+	t := target.Type()
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if f.Anonymous {
+			continue
+		}
+		opts, err := parseFieldOptions(t, f)
+		if err != nil {
+			return err
+		}
+		if !opts.present(buf.protocol()) || (opts.cond != nil && !opts.cond.holds(target)) {
+			continue
+		}
+		value, err := buf.reflectiveReadField(f.Type, &opts)
+		if err != nil {
+			return &FieldError{Type: t, Field: f.Name, Err: err}
+		}
+		target.Field(i).Set(value)
+	}
+	return nil
+}
+
+func (buf *Buffer) reflectiveReadField(t reflect.Type, opts *fieldOptions) (reflect.Value, error) {
+	if opts.optional {
+		present, err := buf.ReadBoolean()
+		if err != nil || !present {
+			return reflect.Zero(t), err
+		}
+		inner := *opts
+		inner.optional = false
+		elem := valueType(t, true)
+		v, err := buf.reflectiveReadField(elem, &inner)
+		if err != nil || elem == t {
+			return v, err
+		}
+		p := reflect.New(elem)
+		p.Elem().Set(v)
+		return p, nil
+	}
+	if t.Kind() == reflect.Slice {
+		length, err := buf.readSliceLength(opts.length, opts.maxLen)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		slice := reflect.MakeSlice(t, length, length)
+		for i := range length {
+			v, err := buf.reflectiveRead(t.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			slice.Index(i).Set(v)
+		}
+		return slice, nil
+	}
+	return buf.reflectiveRead(t)
+}
+
+func (buf *Buffer) reflectiveRead(t reflect.Type) (value reflect.Value, err error) {
+	if read, ok := bufferReadMethod(t); ok {
+		results := read.Call([]reflect.Value{reflect.ValueOf(buf)})
+		if err, _ := results[1].Interface().(error); err != nil {
+			return reflect.Value{}, err
+		}
+		return results[0], nil
+	}
+	var v any
+	switch t {
+	case reflect.TypeFor[VarInt]():
+		v, _, err = buf.ReadVarInt()
+	case reflect.TypeFor[bool]():
+		v, err = buf.ReadBoolean()
+	case reflect.TypeFor[byte]():
+		v, err = buf.ReadByte()
+	case reflect.TypeFor[int32]():
+		v, err = buf.ReadInt()
+	case reflect.TypeFor[int64]():
+		v, err = buf.ReadLong()
+	case reflect.TypeFor[float64]():
+		v, err = buf.ReadDouble()
+	default:
+		if t.Kind() == reflect.Struct {
+			target := reflect.New(t).Elem()
+			return target, buf.reflectiveReadFields(target)
+		}
+		err = fmt.Errorf("unhandled type %v with kind %v", t, t.Kind())
+	}
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	return reflect.ValueOf(v), nil
+}
+
+func (buf *Buffer) reflectiveWriteFields(v reflect.Value) error {
+	t := v.Type()
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if f.Anonymous {
+			continue
+		}
+		opts, err := parseFieldOptions(t, f)
+		if err != nil {
+			return err
+		}
+		if !opts.present(buf.protocol()) || (opts.cond != nil && !opts.cond.holds(v)) {
+			continue
+		}
+		if err := buf.reflectiveWriteField(v.Field(i), &opts); err != nil {
+			return &FieldError{Type: t, Field: f.Name, Err: err}
+		}
+	}
+	return nil
+}
+
+func (buf *Buffer) reflectiveWriteField(v reflect.Value, opts *fieldOptions) error {
+	if opts.optional {
+		buf.WriteBoolean(!v.IsNil())
+		if v.IsNil() {
+			return nil
+		}
+		inner := *opts
+		inner.optional = false
+		if valueType(v.Type(), true) != v.Type() {
+			v = v.Elem()
+		}
+		return buf.reflectiveWriteField(v, &inner)
+	}
+	if v.Kind() == reflect.Slice {
+		if err := buf.writeLength(opts.length, v.Len()); err != nil {
+			return err
+		}
+		for i := range v.Len() {
+			if err := buf.reflectiveWrite(v.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return buf.reflectiveWrite(v.Interface())
+}
+
+func (buf *Buffer) reflectiveWrite(value any) error {
+	if writable, ok := value.(BufferWritable); ok {
+		return writable.BufferWrite(buf)
+	}
+	switch v := value.(type) {
+	case VarInt:
+		buf.WriteVarInt(v)
+	case bool:
+		buf.WriteBoolean(v)
+	case byte:
+		buf.Push(v)
+	case int32:
+		buf.WriteInt(v)
+	case int64:
+		buf.WriteLong(v)
+	case float64:
+		buf.WriteDouble(v)
+	default:
+		reflected := reflect.ValueOf(value)
+		if reflected.Kind() != reflect.Struct {
+			return fmt.Errorf("unhandled type %T", value)
+		}
+		return buf.reflectiveWriteFields(reflected)
+	}
+	return nil
+}
+
+// Like Set Player Position, sent by every moving player every tick.
+type benchMovement struct {
+	X, FeetY, Z float64
+	OnGround    bool
+}
+
+type benchLightArray struct {
+	Data []byte `message:"length:varint"`
+}
+
+// Like Chunk Data and Update Light: a full overworld column with sky light.
+type benchChunk struct {
+	ChunkX, ChunkZ      int32
+	Heightmaps          *NBTValue
+	Data                []byte            `message:"length:varint"`
+	BlockEntities       []VarInt          `message:"length:varint"`
+	SkyLightMask        []int64           `message:"length:varint"`
+	BlockLightMask      []int64           `message:"length:varint"`
+	EmptySkyLightMask   []int64           `message:"length:varint"`
+	EmptyBlockLightMask []int64           `message:"length:varint"`
+	SkyLightArrays      []benchLightArray `message:"length:varint"`
+	BlockLightArrays    []benchLightArray `message:"length:varint"`
+}
+
+func benchMovementValue() *benchMovement {
+	return &benchMovement{X: 12.5, FeetY: 64, Z: -1031.25, OnGround: true}
+}
+
+func benchChunkValue() *benchChunk {
+	rng := rand.New(rand.NewPCG(1, 2))
+	random := func(n int) []byte {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = byte(rng.Uint32())
+		}
+		return b
+	}
+	heightmap := make([]int64, 37)
+	for i := range heightmap {
+		heightmap[i] = int64(rng.Uint64())
+	}
+	c := &benchChunk{
+		ChunkX: 3,
+		ChunkZ: -7,
+		Heightmaps: NBTCompoundValue(nil, []*NBTValue{
+			NBTLongArrayValue("MOTION_BLOCKING", heightmap),
+			NBTLongArrayValue("WORLD_SURFACE", heightmap),
+		}),
+		// 24 sections with 4-bit block palettes
+		Data:                random(24 * (2 + 1 + 17 + 2 + 256*8 + 3)),
+		SkyLightMask:        []int64{1<<26 - 1},
+		BlockLightMask:      []int64{0},
+		EmptySkyLightMask:   []int64{0},
+		EmptyBlockLightMask: []int64{1<<26 - 1},
+	}
+	for range 26 {
+		c.SkyLightArrays = append(c.SkyLightArrays, benchLightArray{Data: random(2048)})
+	}
+	return c
+}
+
+type fieldsImpl struct {
+	name  string
+	read  func(buf *Buffer, target reflect.Value) error
+	write func(buf *Buffer, v reflect.Value) error
+}
+
+var fieldsImpls = []fieldsImpl{
+	{"Compiled", (*Buffer).ReadFields, (*Buffer).WriteFields},
+	{"Reflective", (*Buffer).reflectiveReadFields, (*Buffer).reflectiveWriteFields},
+}
+
+// Both encodings must agree for the comparison to mean anything.
+func TestReflectiveBaseline(t *testing.T) {
+	for _, v := range []any{benchMovementValue(), benchChunkValue()} {
+		var compiled, reflective Buffer
+		if err := compiled.WriteFields(reflect.ValueOf(v).Elem()); err != nil {
+			t.Fatal(err)
+		}
+		if err := reflective.reflectiveWriteFields(reflect.ValueOf(v).Elem()); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(compiled.Raw, reflective.Raw) {
+			t.Fatalf("%T: the encodings differ", v)
+		}
+
+		got := reflect.New(reflect.TypeOf(v).Elem())
+		if err := reflective.reflectiveReadFields(got.Elem()); err != nil {
+			t.Fatal(err)
+		}
+		// Empty slices may read as nil, so compare what they encode to
+		var again Buffer
+		if err := again.WriteFields(got.Elem()); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(again.Raw, compiled.Raw) {
+			t.Fatalf("%T: read %+v, want %+v", v, got, v)
+		}
+	}
+}
+
+func benchmarkWriteFields(b *testing.B, v any) {
+	for _, impl := range fieldsImpls {
+		b.Run(impl.name, func(b *testing.B) {
+			value := reflect.ValueOf(v).Elem()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				var buf Buffer
+				if err := impl.write(&buf, value); err != nil {
+					b.Fatal(err)
+				}
+				b.SetBytes(int64(len(buf.Raw)))
+			}
+		})
+	}
+}
+
+func benchmarkReadFields(b *testing.B, v any) {
+	var encoded Buffer
+	if err := encoded.WriteFields(reflect.ValueOf(v).Elem()); err != nil {
+		b.Fatal(err)
+	}
+	t := reflect.TypeOf(v).Elem()
+	for _, impl := range fieldsImpls {
+		b.Run(impl.name, func(b *testing.B) {
+			b.SetBytes(int64(len(encoded.Raw)))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				buf := Buffer{Raw: encoded.Raw, Strict: true}
+				if err := impl.read(&buf, reflect.New(t).Elem()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkWriteFieldsMovement(b *testing.B) {
+	benchmarkWriteFields(b, benchMovementValue())
+}
+
+func BenchmarkReadFieldsMovement(b *testing.B) {
+	benchmarkReadFields(b, benchMovementValue())
+}
+
+func BenchmarkWriteFieldsChunk(b *testing.B) {
+	benchmarkWriteFields(b, benchChunkValue())
+}
+
+func BenchmarkReadFieldsChunk(b *testing.B) {
+	benchmarkReadFields(b, benchChunkValue())
+}
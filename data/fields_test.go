@@ -0,0 +1,336 @@
+package data
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/brenfwd/gocraft/constants"
+)
+
+type point struct {
+	X, Z int16
+}
+
+type optionalFields struct {
+	Name    *string   `message:"optional,maxlen:16"`
+	Point   *point    `message:"optional"`
+	Missing *int32    `message:"optional"`
+	NBT     *NBTValue `message:"optional"`
+	Enum    *uint8    `message:"optional,varint"`
+}
+
+type varintFields struct {
+	Enum     int32  `message:"varint"`
+	Negative int64  `message:"varint"`
+	Small    uint8  `message:"varint"`
+	Unsigned uint32 `message:"varint"`
+}
+
+type restFields struct {
+	ID   VarInt
+	Data []byte `message:"rest"`
+}
+
+type arrayFields struct {
+	Bytes  [4]byte
+	Shorts [2]int16
+	Points [2]point
+}
+
+type lengthFields struct {
+	ByVarInt []int16  `message:"length:varint"`
+	ByByte   []string `message:"length:byte"`
+	ByShort  []byte   `message:"length:short"`
+	ByInt    []point  `message:"length:int"`
+}
+
+type conditionalFields struct {
+	HasName bool
+	Name    string `message:"if:HasName"`
+	Kind    VarInt
+	Radius  float32 `message:"if:Kind=1"`
+	Size    point   `message:"if:Kind=2"`
+	Count   uint8
+	Items   []int32 `message:"length:varint,if:Count"`
+}
+
+type versionedFields struct {
+	Always  int32
+	Old     string `message:"until:764"`
+	New     bool   `message:"since:765"`
+	Between byte   `message:"since:765,until:766"`
+}
+
+// Writes v and reads it back in the given protocol, checking that everything
+// was read and that it matches want.
+func roundTrip[T any](t *testing.T, protocol constants.ProtocolVersion, v, want T) []byte {
+	t.Helper()
+	buf := Buffer{Protocol: protocol}
+	if err := buf.WriteFields(reflect.ValueOf(v)); err != nil {
+		t.Fatal(err)
+	}
+	encoded := bytes.Clone(buf.Raw)
+
+	var got T
+	read := Buffer{Raw: buf.Raw, Protocol: protocol, Strict: true}
+	if err := read.ReadFields(reflect.ValueOf(&got).Elem()); err != nil {
+		t.Fatalf("reading % x: %v", encoded, err)
+	}
+	if err := read.CheckConsumed(); err != nil {
+		t.Fatalf("reading % x: %v", encoded, err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	return encoded
+}
+
+func checkEncoded(t *testing.T, got []byte, want []byte) {
+	t.Helper()
+	if !bytes.Equal(got, want) {
+		t.Errorf("encoded as % x, want % x", got, want)
+	}
+}
+
+func TestOptionalRoundTrip(t *testing.T) {
+	name := "Notch"
+	enum := uint8(200)
+	v := optionalFields{
+		Name:  &name,
+		Point: &point{X: 1, Z: -1},
+		NBT:   NBTCompoundValue(nil, []*NBTValue{NBTIntValue("a", 1)}),
+		Enum:  &enum,
+	}
+	encoded := roundTrip(t, 0, v, v)
+	if !bytes.HasPrefix(encoded, []byte{1, 5, 'N', 'o', 't', 'c', 'h', 1, 0, 1, 0xff, 0xff, 0, 1}) {
+		t.Errorf("encoded as % x", encoded)
+	}
+	// The varint enum, after its boolean
+	if !bytes.HasSuffix(encoded, []byte{1, 0xc8, 0x01}) {
+		t.Errorf("encoded as % x, want the enum as a VarInt", encoded)
+	}
+
+	encoded = roundTrip(t, 0, optionalFields{}, optionalFields{})
+	checkEncoded(t, encoded, []byte{0, 0, 0, 0, 0})
+}
+
+func TestVarintRoundTrip(t *testing.T) {
+	v := varintFields{Enum: 300, Negative: -1, Small: 255, Unsigned: 1 << 31}
+	encoded := roundTrip(t, 0, v, v)
+	checkEncoded(t, encoded, []byte{
+		0xac, 0x02,
+		0xff, 0xff, 0xff, 0xff, 0x0f,
+		0xff, 0x01,
+		0x80, 0x80, 0x80, 0x80, 0x08,
+	})
+}
+
+func TestRestRoundTrip(t *testing.T) {
+	v := restFields{ID: 7, Data: []byte{1, 2, 3}}
+	checkEncoded(t, roundTrip(t, 0, v, v), []byte{7, 1, 2, 3})
+
+	// Nothing left reads as nil
+	checkEncoded(t, roundTrip(t, 0, restFields{ID: 7}, restFields{ID: 7}), []byte{7})
+}
+
+func TestArrayRoundTrip(t *testing.T) {
+	v := arrayFields{
+		Bytes:  [4]byte{1, 2, 3, 4},
+		Shorts: [2]int16{-2, 5},
+		Points: [2]point{{X: 1, Z: 2}, {X: 3, Z: 4}},
+	}
+	// No length prefixes
+	checkEncoded(t, roundTrip(t, 0, v, v), []byte{
+		1, 2, 3, 4,
+		0xff, 0xfe, 0, 5,
+		0, 1, 0, 2, 0, 3, 0, 4,
+	})
+}
+
+func TestLengthRoundTrip(t *testing.T) {
+	v := lengthFields{
+		ByVarInt: []int16{1},
+		ByByte:   []string{"a", "b"},
+		ByShort:  []byte{9, 8, 7},
+		ByInt:    []point{{X: 1, Z: 2}},
+	}
+	checkEncoded(t, roundTrip(t, 0, v, v), []byte{
+		1, 0, 1,
+		2, 1, 'a', 1, 'b',
+		0, 3, 9, 8, 7,
+		0, 0, 0, 1, 0, 1, 0, 2,
+	})
+
+	// Empty slices read back as empty, not nil
+	empty := lengthFields{ByVarInt: []int16{}, ByByte: []string{}, ByShort: []byte{}, ByInt: []point{}}
+	checkEncoded(t, roundTrip(t, 0, lengthFields{}, empty), []byte{0, 0, 0, 0, 0, 0, 0, 0})
+
+	// A length that doesn't fit its type is an error
+	var buf Buffer
+	if err := buf.WriteFields(reflect.ValueOf(lengthFields{ByByte: make([]string, 256)})); err == nil {
+		t.Error("wrote 256 elements with a byte length")
+	}
+}
+
+func TestConditionalRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       conditionalFields
+		want    conditionalFields
+		encoded []byte
+	}{
+		{"NoneSet", conditionalFields{Kind: 0}, conditionalFields{Kind: 0}, []byte{0, 0, 0}},
+		{"Bool", conditionalFields{HasName: true, Name: "x"}, conditionalFields{HasName: true, Name: "x"}, []byte{1, 1, 'x', 0, 0}},
+		{"Value", conditionalFields{Kind: 1, Radius: 2}, conditionalFields{Kind: 1, Radius: 2}, []byte{0, 1, 0x40, 0, 0, 0, 0}},
+		{"OtherValue", conditionalFields{Kind: 2, Size: point{X: 3, Z: 4}}, conditionalFields{Kind: 2, Size: point{X: 3, Z: 4}}, []byte{0, 2, 0, 3, 0, 4, 0}},
+		{"NonZero", conditionalFields{Count: 1, Items: []int32{5}}, conditionalFields{Count: 1, Items: []int32{5}}, []byte{0, 0, 1, 1, 0, 0, 0, 5}},
+		// Fields whose condition doesn't hold are neither written nor read
+		{"Skipped", conditionalFields{Name: "x", Kind: 3, Radius: 2, Items: []int32{5}}, conditionalFields{Kind: 3}, []byte{0, 3, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkEncoded(t, roundTrip(t, 0, tt.v, tt.want), tt.encoded)
+		})
+	}
+}
+
+func TestVersionedRoundTrip(t *testing.T) {
+	v := versionedFields{Always: 1, Old: "o", New: true, Between: 2}
+	tests := []struct {
+		protocol constants.ProtocolVersion
+		want     versionedFields
+		encoded  []byte
+	}{
+		{constants.Protocol1_20_2, versionedFields{Always: 1, Old: "o"}, []byte{0, 0, 0, 1, 1, 'o'}},
+		{constants.Protocol1_20_3, versionedFields{Always: 1, New: true, Between: 2}, []byte{0, 0, 0, 1, 1, 2}},
+		{constants.Protocol1_20_5, versionedFields{Always: 1, New: true, Between: 2}, []byte{0, 0, 0, 1, 1, 2}},
+		{constants.Protocol1_21, versionedFields{Always: 1, New: true}, []byte{0, 0, 0, 1, 1}},
+		// Unset is the latest
+		{0, versionedFields{Always: 1, New: true}, []byte{0, 0, 0, 1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.protocol.String(), func(t *testing.T) {
+			checkEncoded(t, roundTrip(t, tt.protocol, v, tt.want), tt.encoded)
+		})
+	}
+}
+
+func TestFieldTagErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		t    reflect.Type
+		// Part of the error message
+		err string
+	}{
+		{"RestNotBytes", reflect.TypeFor[struct {
+			A string `message:"rest"`
+		}](), "has the rest option but is not a []byte"},
+		{"MaxLenNotNumber", reflect.TypeFor[struct {
+			A string `message:"maxlen:many"`
+		}](), `invalid maxlen "many"`},
+		{"MaxLenZero", reflect.TypeFor[struct {
+			A string `message:"maxlen:0"`
+		}](), `invalid maxlen "0"`},
+		{"OptionalNotPointer", reflect.TypeFor[struct {
+			A int32 `message:"optional"`
+		}](), "has the optional option but is not a pointer"},
+		{"VarintNotInteger", reflect.TypeFor[struct {
+			A float32 `message:"varint"`
+		}](), "has the varint option but is not an integer"},
+		{"OptionalVarintNotInteger", reflect.TypeFor[struct {
+			A *string `message:"optional,varint"`
+		}](), "has the varint option but is not an integer"},
+		{"IfMissingField", reflect.TypeFor[struct {
+			A int32 `message:"if:B"`
+		}](), `depends on "B", which is not an earlier field`},
+		{"IfLaterField", reflect.TypeFor[struct {
+			A int32 `message:"if:B"`
+			B bool
+		}](), `depends on "B", which is not an earlier field`},
+		{"IfItself", reflect.TypeFor[struct {
+			A bool `message:"if:A"`
+		}](), `depends on "A", which is not an earlier field`},
+		{"IfString", reflect.TypeFor[struct {
+			A string
+			B int32 `message:"if:A"`
+		}](), "depends on A, which is not a boolean or integer"},
+		{"IfValueOnBool", reflect.TypeFor[struct {
+			A bool
+			B int32 `message:"if:A=1"`
+		}](), `invalid condition "A=1"`},
+		{"IfValueNotNumber", reflect.TypeFor[struct {
+			A int32
+			B int32 `message:"if:A=x"`
+		}](), `invalid condition "A=x"`},
+		{"SinceNotNumber", reflect.TypeFor[struct {
+			A int32 `message:"since:1.21"`
+		}](), `invalid since version "1.21"`},
+		{"UntilNotNumber", reflect.TypeFor[struct {
+			A int32 `message:"until:"`
+		}](), `invalid until version ""`},
+		{"UnknownOption", reflect.TypeFor[struct {
+			A int32 `message:"signed"`
+		}](), `unknown option "signed"`},
+		{"SliceWithoutLength", reflect.TypeFor[struct {
+			A []int32
+		}](), "is a slice type but is missing"},
+		{"UnknownLength", reflect.TypeFor[struct {
+			A []int32 `message:"length:long"`
+		}](), `unhandled length type "long"`},
+		{"UnhandledType", reflect.TypeFor[struct {
+			A map[string]int
+		}](), "unhandled type map[string]int"},
+		{"NotStruct", reflect.TypeFor[int32](), "is not a struct"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Compile(tt.t)
+			if err == nil {
+				t.Fatal("compiled, want an error")
+			}
+			if !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("got %q, want it to contain %q", err, tt.err)
+			}
+			// Reading and writing fail the same way
+			var buf Buffer
+			if err := buf.WriteFields(reflect.New(tt.t).Elem()); err == nil {
+				t.Error("wrote a value of the type")
+			}
+		})
+	}
+}
+
+func TestFieldConditionHolds(t *testing.T) {
+	type fields struct {
+		Bool   bool
+		Int    int8
+		Uint   uint16
+		VarInt VarInt
+	}
+	v := reflect.ValueOf(fields{Bool: true, Int: -3, Uint: 7, VarInt: 0})
+	tests := []struct {
+		cond fieldCondition
+		want bool
+	}{
+		{fieldCondition{field: 0}, true},
+		{fieldCondition{field: 1}, true},
+		{fieldCondition{field: 1, value: -3, hasValue: true}, true},
+		{fieldCondition{field: 1, value: 3, hasValue: true}, false},
+		{fieldCondition{field: 2, value: 7, hasValue: true}, true},
+		{fieldCondition{field: 2, value: 0, hasValue: true}, false},
+		{fieldCondition{field: 3}, false},
+		{fieldCondition{field: 3, value: 0, hasValue: true}, true},
+	}
+	for _, tt := range tests {
+		if got := tt.cond.holds(v); got != tt.want {
+			t.Errorf("%+v holds = %v, want %v", tt.cond, got, tt.want)
+		}
+	}
+
+	f := reflect.ValueOf(fields{})
+	if (&fieldCondition{field: 0}).holds(f) {
+		t.Error("false holds")
+	}
+}
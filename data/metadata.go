@@ -0,0 +1,365 @@
+package data
+
+import (
+	"fmt"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/google/uuid"
+)
+
+// Entity Metadata value types. The Go type expected in MetadataEntry.Value is
+// noted next to each type.
+type MetadataType int32
+
+const (
+	MetadataByte             MetadataType = iota // byte
+	MetadataVarInt                               // VarInt
+	MetadataVarLong                              // VarLong
+	MetadataFloat                                // float32
+	MetadataString                               // string
+	MetadataTextComponent                        // *Chat
+	MetadataOptTextComponent                     // *Chat, nil if absent
+	MetadataSlot                                 // unsupported
+	MetadataBoolean                              // bool
+	MetadataRotations                            // [3]float32
+	MetadataPosition                             // Position
+	MetadataOptPosition                          // *Position, nil if absent
+	MetadataDirection                            // VarInt
+	MetadataOptUUID                              // *uuid.UUID, nil if absent
+	MetadataBlockState                           // VarInt
+	MetadataOptBlockState                        // VarInt, 0 if absent
+	MetadataNBT                                  // *NBTValue
+	MetadataParticle                             // unsupported
+	MetadataParticles                            // unsupported
+	MetadataVillagerData                         // [3]VarInt (type, profession, level)
+	MetadataOptVarInt                            // *VarInt, nil if absent
+	MetadataPose                                 // VarInt
+	MetadataCatVariant                           // VarInt
+	MetadataWolfVariant                          // VarInt
+	MetadataFrogVariant                          // VarInt
+	MetadataOptGlobalPos                         // unsupported
+	MetadataPaintingVariant                      // VarInt
+	MetadataSnifferState                         // VarInt
+	MetadataArmadilloState                       // VarInt
+	MetadataVector3                              // [3]float32
+	MetadataQuaternion                           // [4]float32
+)
+
+const metadataEnd byte = 0xFF
+
+// Before 1.20.5 there was no Particles, Wolf Variant or Armadillo State type and
+// the types after them were numbered lower.
+var metadataTypesBefore1_20_5 = map[MetadataType]int32{
+	MetadataVillagerData:    18,
+	MetadataOptVarInt:       19,
+	MetadataPose:            20,
+	MetadataCatVariant:      21,
+	MetadataFrogVariant:     22,
+	MetadataOptGlobalPos:    23,
+	MetadataPaintingVariant: 24,
+	MetadataSnifferState:    25,
+	MetadataVector3:         26,
+	MetadataQuaternion:      27,
+}
+
+// Returns the ID of the type in the given protocol version.
+func metadataTypeID(t MetadataType, protocol constants.ProtocolVersion) (int32, error) {
+	if protocol >= constants.Protocol1_20_5 || t <= MetadataParticle {
+		return int32(t), nil
+	}
+	id, ok := metadataTypesBefore1_20_5[t]
+	if !ok {
+		return 0, fmt.Errorf("metadata type %d does not exist in protocol %d", t, protocol)
+	}
+	return id, nil
+}
+
+func metadataTypeFromID(id int32, protocol constants.ProtocolVersion) (MetadataType, error) {
+	if protocol >= constants.Protocol1_20_5 || id <= int32(MetadataParticle) {
+		return MetadataType(id), nil
+	}
+	for t, oldID := range metadataTypesBefore1_20_5 {
+		if oldID == id {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown metadata type %d in protocol %d", id, protocol)
+}
+
+type MetadataEntry struct {
+	Index byte
+	Type  MetadataType
+	Value any
+}
+
+// Entity Metadata as sent in Set Entity Metadata: a list of entries terminated by 0xFF.
+type EntityMetadata struct {
+	Entries []MetadataEntry
+}
+
+func (m *EntityMetadata) BufferWrite(buf *Buffer) error {
+	for _, entry := range m.Entries {
+		if entry.Index == metadataEnd {
+			return fmt.Errorf("metadata index 0x%02x is reserved", metadataEnd)
+		}
+		id, err := metadataTypeID(entry.Type, buf.protocol())
+		if err != nil {
+			return fmt.Errorf("metadata index %d: %w", entry.Index, err)
+		}
+		buf.Push(entry.Index)
+		buf.WriteVarInt(VarInt(id))
+		if err := writeMetadataValue(buf, entry.Type, entry.Value); err != nil {
+			return fmt.Errorf("metadata index %d: %w", entry.Index, err)
+		}
+	}
+	buf.Push(metadataEnd)
+	return nil
+}
+
+func (m *EntityMetadata) BufferRead(buf *Buffer) (*EntityMetadata, error) {
+	result := &EntityMetadata{}
+	for {
+		index, err := buf.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if index == metadataEnd {
+			return result, nil
+		}
+		id, _, err := buf.ReadVarInt()
+		if err != nil {
+			return nil, err
+		}
+		t, err := metadataTypeFromID(int32(id), buf.protocol())
+		if err != nil {
+			return nil, fmt.Errorf("metadata index %d: %w", index, err)
+		}
+		value, err := readMetadataValue(buf, t)
+		if err != nil {
+			return nil, fmt.Errorf("metadata index %d: %w", index, err)
+		}
+		result.Entries = append(result.Entries, MetadataEntry{Index: index, Type: t, Value: value})
+	}
+}
+
+func metadataTypeError(t MetadataType, v any) error {
+	return fmt.Errorf("metadata value %v (%T) does not match metadata type %d", v, v, t)
+}
+
+func writeMetadataValue(buf *Buffer, t MetadataType, value any) error {
+	switch t {
+	case MetadataByte:
+		v, ok := value.(byte)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		buf.Push(v)
+	case MetadataVarInt, MetadataDirection, MetadataBlockState, MetadataOptBlockState, MetadataPose,
+		MetadataCatVariant, MetadataWolfVariant, MetadataFrogVariant, MetadataPaintingVariant,
+		MetadataSnifferState, MetadataArmadilloState:
+		v, ok := value.(VarInt)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		buf.WriteVarInt(v)
+	case MetadataVarLong:
+		v, ok := value.(VarLong)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		buf.WriteVarLong(v)
+	case MetadataFloat:
+		v, ok := value.(float32)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		buf.WriteFloat(v)
+	case MetadataString:
+		v, ok := value.(string)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		buf.WriteString(v)
+	case MetadataTextComponent:
+		v, ok := value.(*Chat)
+		if !ok || v == nil {
+			return metadataTypeError(t, value)
+		}
+		return writeTextComponent(buf, v)
+	case MetadataOptTextComponent:
+		v, ok := value.(*Chat)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		buf.WriteBoolean(v != nil)
+		if v != nil {
+			return writeTextComponent(buf, v)
+		}
+	case MetadataBoolean:
+		v, ok := value.(bool)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		buf.WriteBoolean(v)
+	case MetadataRotations, MetadataVector3:
+		v, ok := value.([3]float32)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		for _, f := range v {
+			buf.WriteFloat(f)
+		}
+	case MetadataQuaternion:
+		v, ok := value.([4]float32)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		for _, f := range v {
+			buf.WriteFloat(f)
+		}
+	case MetadataPosition:
+		v, ok := value.(Position)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		buf.WriteLong(v.Pack())
+	case MetadataOptPosition:
+		v, ok := value.(*Position)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		buf.WriteBoolean(v != nil)
+		if v != nil {
+			buf.WriteLong(v.Pack())
+		}
+	case MetadataOptUUID:
+		v, ok := value.(*uuid.UUID)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		buf.WriteBoolean(v != nil)
+		if v != nil {
+			buf.WriteUUID(*v)
+		}
+	case MetadataNBT:
+		v, ok := value.(*NBTValue)
+		if !ok || v == nil {
+			return metadataTypeError(t, value)
+		}
+		return v.BufferWrite(buf)
+	case MetadataVillagerData:
+		v, ok := value.([3]VarInt)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		for _, i := range v {
+			buf.WriteVarInt(i)
+		}
+	case MetadataOptVarInt:
+		v, ok := value.(*VarInt)
+		if !ok {
+			return metadataTypeError(t, value)
+		}
+		if v == nil {
+			buf.WriteVarInt(0)
+		} else {
+			buf.WriteVarInt(*v + 1)
+		}
+	default:
+		return fmt.Errorf("unsupported metadata type %d", t)
+	}
+	return nil
+}
+
+// Text components are sent as NBT since 1.20.3 and as JSON before.
+func writeTextComponent(buf *Buffer, c *Chat) error {
+	if buf.protocol() >= constants.Protocol1_20_3 {
+		return c.ToNBT(nil).BufferWrite(buf)
+	}
+	s, err := c.String()
+	if err != nil {
+		return err
+	}
+	buf.WriteString(s)
+	return nil
+}
+
+func readMetadataValue(buf *Buffer, t MetadataType) (any, error) {
+	switch t {
+	case MetadataByte:
+		return buf.ReadByte()
+	case MetadataVarInt, MetadataDirection, MetadataBlockState, MetadataOptBlockState, MetadataPose,
+		MetadataCatVariant, MetadataWolfVariant, MetadataFrogVariant, MetadataPaintingVariant,
+		MetadataSnifferState, MetadataArmadilloState:
+		v, _, err := buf.ReadVarInt()
+		return v, err
+	case MetadataVarLong:
+		v, _, err := buf.ReadVarLong()
+		return v, err
+	case MetadataFloat:
+		return buf.ReadFloat()
+	case MetadataString:
+		v, _, err := buf.ReadString()
+		return v, err
+	case MetadataBoolean:
+		return buf.ReadBoolean()
+	case MetadataRotations, MetadataVector3:
+		var v [3]float32
+		for i := range v {
+			f, err := buf.ReadFloat()
+			if err != nil {
+				return nil, err
+			}
+			v[i] = f
+		}
+		return v, nil
+	case MetadataQuaternion:
+		var v [4]float32
+		for i := range v {
+			f, err := buf.ReadFloat()
+			if err != nil {
+				return nil, err
+			}
+			v[i] = f
+		}
+		return v, nil
+	case MetadataPosition:
+		v, err := buf.ReadLong()
+		return UnpackPosition(v), err
+	case MetadataOptPosition:
+		present, err := buf.ReadBoolean()
+		if err != nil || !present {
+			return (*Position)(nil), err
+		}
+		v, err := buf.ReadLong()
+		pos := UnpackPosition(v)
+		return &pos, err
+	case MetadataOptUUID:
+		present, err := buf.ReadBoolean()
+		if err != nil || !present {
+			return (*uuid.UUID)(nil), err
+		}
+		v, err := buf.ReadUUID()
+		return &v, err
+	case MetadataVillagerData:
+		var v [3]VarInt
+		for i := range v {
+			n, _, err := buf.ReadVarInt()
+			if err != nil {
+				return nil, err
+			}
+			v[i] = n
+		}
+		return v, nil
+	case MetadataOptVarInt:
+		v, _, err := buf.ReadVarInt()
+		if err != nil || v == 0 {
+			return (*VarInt)(nil), err
+		}
+		v--
+		return &v, nil
+	default:
+		// TODO: text components and NBT need an NBT reader
+		return nil, fmt.Errorf("unsupported metadata type %d", t)
+	}
+}
@@ -2,6 +2,9 @@ package data
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 )
 
 //go:generate stringer -type=NBTTag
@@ -54,7 +57,7 @@ func NBTByteValue(name string, val byte) *NBTValue {
 }
 
 func NBTShortValue(name string, val int16) *NBTValue {
-	return makeValue(TAG_Byte, &name, val)
+	return makeValue(TAG_Short, &name, val)
 }
 
 func NBTIntValue(name string, val int32) *NBTValue {
@@ -131,12 +134,18 @@ func (v *NBTValue) bufferWriteInternal(buf *Buffer, s state) error {
 
 	// Write payload itself
 	switch v.Tag {
+	case TAG_End:
+		// No payload
 	case TAG_Compound:
 		entries := v.Value.([]*NBTValue)
 		for _, entry := range entries {
-			entry.bufferWriteInternal(buf, state_InCompound)
+			if err := entry.bufferWriteInternal(buf, state_InCompound); err != nil {
+				return err
+			}
+		}
+		if err := endValue.bufferWriteInternal(buf, state_InCompound); err != nil {
+			return err
 		}
-		endValue.bufferWriteInternal(buf, state_InCompound)
 	case TAG_List:
 		entries := v.Value.([]*NBTValue)
 		var t NBTTag
@@ -151,7 +160,9 @@ func (v *NBTValue) bufferWriteInternal(buf *Buffer, s state) error {
 			if entry.Tag != t {
 				return fmt.Errorf("inconsistent types in list: expected all to be of type %v but got an element of type %v", t, entry.Tag)
 			}
-			entry.bufferWriteInternal(buf, state_InList)
+			if err := entry.bufferWriteInternal(buf, state_InList); err != nil {
+				return err
+			}
 		}
 	case TAG_Byte:
 		buf.Push(v.Value.(byte))
@@ -193,11 +204,246 @@ func (v *NBTValue) bufferWriteInternal(buf *Buffer, s state) error {
 	return nil
 }
 
-func (v *NBTValue) BufferWrite(buf *Buffer) {
-	err := v.bufferWriteInternal(buf, state_Default)
+func (v *NBTValue) BufferWrite(buf *Buffer) error {
+	return v.bufferWriteInternal(buf, state_Default)
+}
+
+// Nesting limit when reading, like vanilla.
+const nbtMaxDepth = 512
+
+// Reads NBT in the network format (a nameless root tag), the counterpart of
+// BufferWrite.
+func (v *NBTValue) BufferRead(buf *Buffer) (*NBTValue, error) {
+	tag, err := buf.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	return readNBTValue(buf, NBTTag(tag), nil, 0)
+}
+
+// Writes NBT in the file format, where the root tag has a name like compound
+// entries do. A nil Name is written as "", which is what vanilla uses.
+func (v *NBTValue) WriteNamed(buf *Buffer) error {
+	if v.Name == nil {
+		named := *v
+		named.Name = new(string)
+		v = &named
+	}
+	return v.bufferWriteInternal(buf, state_InCompound)
+}
+
+// Reads NBT in the file format (a named root tag), the counterpart of
+// WriteNamed.
+func ReadNamedNBT(buf *Buffer) (*NBTValue, error) {
+	tag, err := buf.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if NBTTag(tag) == TAG_End {
+		return makeValue(TAG_End, nil, nil), nil
+	}
+	name, err := readNBTString(buf)
+	if err != nil {
+		return nil, err
+	}
+	return readNBTValue(buf, NBTTag(tag), &name, 0)
+}
+
+func readNBTString(buf *Buffer) (string, error) {
+	length, err := buf.ReadUShort()
+	if err != nil {
+		return "", err
+	}
+	bytes, err := buf.Read(int(length))
+	return string(bytes), err
+}
+
+func readNBTLength(buf *Buffer) (int, error) {
+	length, err := buf.ReadInt()
+	if err != nil {
+		return 0, err
+	}
+	if length < 0 || int(length) > buf.Length() {
+		return 0, fmt.Errorf("invalid nbt array length %d", length)
+	}
+	return int(length), nil
+}
+
+func readNBTValue(buf *Buffer, tag NBTTag, name *string, depth int) (*NBTValue, error) {
+	if depth > nbtMaxDepth {
+		return nil, fmt.Errorf("nbt nested deeper than %d", nbtMaxDepth)
+	}
+
+	var value any
+	var err error
+	switch tag {
+	case TAG_End:
+	case TAG_Byte:
+		value, err = buf.ReadByte()
+	case TAG_Short:
+		value, err = buf.ReadShort()
+	case TAG_Int:
+		value, err = buf.ReadInt()
+	case TAG_Long:
+		value, err = buf.ReadLong()
+	case TAG_Float:
+		value, err = buf.ReadFloat()
+	case TAG_Double:
+		value, err = buf.ReadDouble()
+	case TAG_Byte_Array:
+		var length int
+		if length, err = readNBTLength(buf); err == nil {
+			var bytes []byte
+			bytes, err = buf.Read(length)
+			// Copied, the buffer may be reused
+			value = slices.Clone(bytes)
+		}
+	case TAG_String:
+		value, err = readNBTString(buf)
+	case TAG_List:
+		var elemTag byte
+		if elemTag, err = buf.ReadByte(); err != nil {
+			return nil, err
+		}
+		length, err := readNBTLength(buf)
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]*NBTValue, length)
+		for i := range entries {
+			if entries[i], err = readNBTValue(buf, NBTTag(elemTag), nil, depth+1); err != nil {
+				return nil, err
+			}
+		}
+		value = entries
+	case TAG_Compound:
+		entries := make([]*NBTValue, 0)
+		for {
+			entryTag, err := buf.ReadByte()
+			if err != nil {
+				return nil, err
+			}
+			if NBTTag(entryTag) == TAG_End {
+				break
+			}
+			entryName, err := readNBTString(buf)
+			if err != nil {
+				return nil, err
+			}
+			entry, err := readNBTValue(buf, NBTTag(entryTag), &entryName, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry)
+		}
+		value = entries
+	case TAG_Int_Array:
+		var length int
+		if length, err = readNBTLength(buf); err == nil {
+			values := make([]int32, length)
+			for i := range values {
+				if values[i], err = buf.ReadInt(); err != nil {
+					break
+				}
+			}
+			value = values
+		}
+	case TAG_Long_Array:
+		var length int
+		if length, err = readNBTLength(buf); err == nil {
+			values := make([]int64, length)
+			for i := range values {
+				if values[i], err = buf.ReadLong(); err != nil {
+					break
+				}
+			}
+			value = values
+		}
+	default:
+		return nil, fmt.Errorf("unhandled nbt tag type %v", tag)
+	}
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
+	return makeValue(tag, name, value), nil
 }
 
-// TODO: buffer read...
+// Returns the entry of a compound with the given name, or nil.
+func (v *NBTValue) Get(name string) *NBTValue {
+	entries, ok := v.Value.([]*NBTValue)
+	if v.Tag != TAG_Compound || !ok {
+		return nil
+	}
+	for _, entry := range entries {
+		if entry.Name != nil && *entry.Name == name {
+			return entry
+		}
+	}
+	return nil
+}
+
+// Formats the value like SNBT, for debugging.
+func (v *NBTValue) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var sb strings.Builder
+	v.writeSNBT(&sb)
+	return sb.String()
+}
+
+func (v *NBTValue) writeSNBT(sb *strings.Builder) {
+	switch value := v.Value.(type) {
+	case []*NBTValue:
+		start, end := "[", "]"
+		if v.Tag == TAG_Compound {
+			start, end = "{", "}"
+		}
+		sb.WriteString(start)
+		for i, entry := range value {
+			if i > 0 {
+				sb.WriteString(",")
+			}
+			if v.Tag == TAG_Compound && entry.Name != nil {
+				sb.WriteString(strconv.Quote(*entry.Name) + ":")
+			}
+			entry.writeSNBT(sb)
+		}
+		sb.WriteString(end)
+	case string:
+		sb.WriteString(strconv.Quote(value))
+	case byte:
+		fmt.Fprintf(sb, "%db", int8(value))
+	case int16:
+		fmt.Fprintf(sb, "%ds", value)
+	case int64:
+		fmt.Fprintf(sb, "%dL", value)
+	case float32:
+		fmt.Fprintf(sb, "%gf", value)
+	case float64:
+		fmt.Fprintf(sb, "%gd", value)
+	case []byte:
+		signed := make([]int8, len(value))
+		for i, b := range value {
+			signed[i] = int8(b)
+		}
+		writeSNBTArray(sb, "B", signed, "b")
+	case []int32:
+		writeSNBTArray(sb, "I", value, "")
+	case []int64:
+		writeSNBTArray(sb, "L", value, "L")
+	default:
+		fmt.Fprint(sb, value)
+	}
+}
+
+func writeSNBTArray[T int8 | int32 | int64](sb *strings.Builder, prefix string, values []T, suffix string) {
+	sb.WriteString("[" + prefix + ";")
+	for i, v := range values {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		fmt.Fprintf(sb, "%d%s", v, suffix)
+	}
+	sb.WriteString("]")
+}
@@ -0,0 +1,24 @@
+package data
+
+import (
+	"crypto/md5"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+// Usernames vanilla accepts: 3 to 16 letters, digits and underscores.
+var usernameRegexp = regexp.MustCompile(`^[A-Za-z0-9_]{3,16}$`)
+
+func ValidUsername(name string) bool {
+	return usernameRegexp.MatchString(name)
+}
+
+// The UUID vanilla servers give offline players: a name-based (version 3) UUID
+// of "OfflinePlayer:<name>", without a namespace.
+func OfflineUUID(username string) uuid.UUID {
+	sum := md5.Sum([]byte("OfflinePlayer:" + username))
+	sum[6] = sum[6]&0x0f | 0x30
+	sum[8] = sum[8]&0x3f | 0x80
+	return uuid.UUID(sum)
+}
@@ -0,0 +1,26 @@
+package data
+
+// JSON response to a Status Request (server list ping).
+type StatusResponse struct {
+	Version            StatusVersion `json:"version"`
+	Players            StatusPlayers `json:"players"`
+	Description        *Chat         `json:"description,omitempty"`
+	Favicon            string        `json:"favicon,omitempty"` // data:image/png;base64,...
+	EnforcesSecureChat bool          `json:"enforcesSecureChat"`
+}
+
+type StatusVersion struct {
+	Name     string `json:"name"`
+	Protocol int    `json:"protocol"`
+}
+
+type StatusPlayers struct {
+	Max    int                  `json:"max"`
+	Online int                  `json:"online"`
+	Sample []StatusPlayerSample `json:"sample"`
+}
+
+type StatusPlayerSample struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
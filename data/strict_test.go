@@ -0,0 +1,193 @@
+package data
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type strictString struct {
+	Name string `message:"maxlen:4"`
+}
+
+type strictSlice struct {
+	Values []int32 `message:"length:varint,maxlen:2"`
+	Bytes  []byte  `message:"length:byte,maxlen:3"`
+}
+
+type strictUnlimited struct {
+	Text string
+	Chat Chat
+}
+
+type strictNested struct {
+	Inner strictString
+}
+
+// Reads itself, like NBTValue: the generic encoding of its fields isn't used.
+type selfReading struct {
+	value int32
+}
+
+func (s selfReading) BufferRead(buf *Buffer) (selfReading, error) {
+	b, err := buf.ReadByte()
+	return selfReading{value: int32(b) * 10}, err
+}
+
+func (s selfReading) BufferWrite(buf *Buffer) error {
+	buf.Push(byte(s.value / 10))
+	return nil
+}
+
+type withSelfReading struct {
+	Value    selfReading
+	Optional *NBTValue `message:"optional"`
+}
+
+// A body as the fields would be written, built with the Buffer methods.
+func body(write func(buf *Buffer)) []byte {
+	var buf Buffer
+	write(&buf)
+	return buf.Raw
+}
+
+func TestStrictRead(t *testing.T) {
+	tests := []struct {
+		name   string
+		target any
+		body   []byte
+		// The error in strict mode, and whether reading without it succeeds
+		err     error
+		lenient bool
+	}{
+		{"StringAtMax", &strictString{}, body(func(b *Buffer) { b.WriteString("abcd") }), nil, true},
+		{"StringOverMax", &strictString{}, body(func(b *Buffer) { b.WriteString("abcde") }), ErrStringTooLong, true},
+		// Characters are counted, not bytes
+		{"MultibyteAtMax", &strictString{}, body(func(b *Buffer) { b.WriteString("éééé") }), nil, true},
+		// Outside the BMP, each is two UTF-16 code units
+		{"SurrogatePairs", &strictString{}, body(func(b *Buffer) { b.WriteString("😀😀😀") }), ErrStringTooLong, true},
+		// Rejected from the length alone, without reading the string
+		{"StringLengthOverMax", &strictString{}, body(func(b *Buffer) { b.WriteVarInt(13) }), ErrStringTooLong, false},
+		{"StringNegativeLength", &strictString{}, body(func(b *Buffer) { b.WriteVarInt(-1) }), ErrInvalidLength, false},
+		{"StringPastEnd", &strictString{}, body(func(b *Buffer) { b.WriteVarInt(3); b.Write([]byte("ab")) }), ErrBufferTooShort, false},
+		{"DefaultStringMax", &strictUnlimited{}, body(func(b *Buffer) {
+			b.WriteString(strings.Repeat("a", DefaultMaxStringLength+1))
+			b.WriteString(`{"text":""}`)
+		}), ErrStringTooLong, true},
+
+		{"SliceAtMax", &strictSlice{}, body(func(b *Buffer) {
+			b.WriteVarInt(2)
+			b.WriteInt(1)
+			b.WriteInt(2)
+			b.WriteUByte(3)
+			b.Write([]byte{1, 2, 3})
+		}), nil, true},
+		{"SliceOverMax", &strictSlice{}, body(func(b *Buffer) {
+			b.WriteVarInt(3)
+			b.WriteInt(1)
+			b.WriteInt(2)
+			b.WriteInt(3)
+			b.WriteUByte(0)
+		}), ErrArrayTooLong, true},
+		{"BytesOverMax", &strictSlice{}, body(func(b *Buffer) {
+			b.WriteVarInt(0)
+			b.WriteUByte(4)
+			b.Write([]byte{1, 2, 3, 4})
+		}), ErrArrayTooLong, true},
+		{"SliceNegativeLength", &strictSlice{}, body(func(b *Buffer) { b.WriteVarInt(-1) }), ErrInvalidLength, false},
+		// Checked before allocating, in both modes
+		{"SlicePastEnd", &strictSlice{}, body(func(b *Buffer) { b.WriteVarInt(2); b.WriteInt(1) }), ErrBufferTooShort, false},
+		{"HugeSlice", &strictSlice{}, body(func(b *Buffer) { b.WriteVarInt(1 << 30) }), ErrArrayTooLong, false},
+
+		{"SelfReading", &withSelfReading{}, []byte{4, 0}, nil, true},
+		{"SelfReadingPastEnd", &withSelfReading{}, nil, ErrBufferTooShort, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := reflect.ValueOf(tt.target).Elem()
+			buf := NewBufferFromBytes(tt.body)
+			buf.Strict = true
+			err := buf.ReadFields(target)
+			if tt.err == nil && err != nil {
+				t.Fatalf("strict: %v", err)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("strict: got %v, want %v", err, tt.err)
+			}
+
+			lenient := NewBufferFromBytes(tt.body)
+			err = lenient.ReadFields(reflect.New(target.Type()).Elem())
+			if (err == nil) != tt.lenient {
+				t.Errorf("lenient: got %v, want success %v", err, tt.lenient)
+			}
+		})
+	}
+}
+
+func TestStrictSelfReadingValue(t *testing.T) {
+	var v withSelfReading
+	buf := NewBufferFromBytes([]byte{4, 0})
+	buf.Strict = true
+	if err := buf.ReadFields(reflect.ValueOf(&v).Elem()); err != nil {
+		t.Fatal(err)
+	}
+	// Read with its BufferRead method rather than as a struct without fields
+	if v.Value.value != 40 || v.Optional != nil {
+		t.Errorf("got %+v", v)
+	}
+	if err := buf.CheckConsumed(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCheckConsumed(t *testing.T) {
+	buf := NewBufferFromBytes(body(func(b *Buffer) {
+		b.WriteString("abc")
+		b.Write([]byte{1, 2})
+	}))
+	buf.Strict = true
+	var v strictString
+	if err := buf.ReadFields(reflect.ValueOf(&v).Elem()); err != nil {
+		t.Fatal(err)
+	}
+	if err := buf.CheckConsumed(); !errors.Is(err, ErrTrailingBytes) {
+		t.Fatalf("got %v with 2 bytes left, want ErrTrailingBytes", err)
+	}
+
+	buf.Strict = false
+	if err := buf.CheckConsumed(); err != nil {
+		t.Errorf("got %v outside strict mode", err)
+	}
+	buf.Strict = true
+	buf.Read(2)
+	if err := buf.CheckConsumed(); err != nil {
+		t.Errorf("got %v once everything was read", err)
+	}
+}
+
+func TestFieldError(t *testing.T) {
+	buf := NewBufferFromBytes(body(func(b *Buffer) { b.WriteString("abcde") }))
+	buf.Strict = true
+	var v strictNested
+	err := buf.ReadFields(reflect.ValueOf(&v).Elem())
+
+	// The outermost field, wrapping the one that failed
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("got %T %v, want a *FieldError", err, err)
+	}
+	if fieldErr.Type != reflect.TypeFor[strictNested]() || fieldErr.Field != "Inner" {
+		t.Errorf("got the error for %v.%s, want strictNested.Inner", fieldErr.Type, fieldErr.Field)
+	}
+	var inner *FieldError
+	if !errors.As(fieldErr.Err, &inner) || inner.Field != "Name" {
+		t.Errorf("got %v inside, want the error for Name", fieldErr.Err)
+	}
+	if !errors.Is(err, ErrStringTooLong) {
+		t.Errorf("got %v, want it to wrap ErrStringTooLong", err)
+	}
+	if want := "data.strictNested.Inner: data.strictString.Name: "; !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("got message %q, want it to start with %q", err.Error(), want)
+	}
+}
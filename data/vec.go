@@ -0,0 +1,52 @@
+package data
+
+import (
+	"math"
+)
+
+type Vec3 struct {
+	X, Y, Z float64
+}
+
+func (v Vec3) Add(o Vec3) Vec3 {
+	return Vec3{X: v.X + o.X, Y: v.Y + o.Y, Z: v.Z + o.Z}
+}
+
+func (v Vec3) Sub(o Vec3) Vec3 {
+	return Vec3{X: v.X - o.X, Y: v.Y - o.Y, Z: v.Z - o.Z}
+}
+
+func (v Vec3) IsFinite() bool {
+	for _, c := range [...]float64{v.X, v.Y, v.Z} {
+		if math.IsNaN(c) || math.IsInf(c, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// Block position, encoded on the wire as a single packed long.
+type Position struct {
+	X, Y, Z int32
+}
+
+func (p Position) Pack() int64 {
+	return (int64(p.X)&0x3FFFFFF)<<38 | (int64(p.Z)&0x3FFFFFF)<<12 | int64(p.Y)&0xFFF
+}
+
+func UnpackPosition(v int64) Position {
+	return Position{
+		X: int32(v >> 38),
+		Y: int32(v << 52 >> 52),
+		Z: int32(v << 26 >> 38),
+	}
+}
+
+// Block position containing the given point.
+func (v Vec3) BlockPosition() Position {
+	return Position{
+		X: int32(math.Floor(v.X)),
+		Y: int32(math.Floor(v.Y)),
+		Z: int32(math.Floor(v.Z)),
+	}
+}
@@ -0,0 +1,107 @@
+package event
+
+import (
+	"log"
+	"reflect"
+	"runtime/debug"
+	"slices"
+	"sync"
+)
+
+// Handlers run from lowest to highest priority, so higher priorities get the
+// final say on cancellation. Monitor handlers run last and should only observe.
+type Priority int
+
+const (
+	PriorityLowest Priority = iota
+	PriorityLow
+	PriorityNormal
+	PriorityHigh
+	PriorityHighest
+	PriorityMonitor
+)
+
+// Implemented by events that can be cancelled, by embedding Cancel.
+type Cancellable interface {
+	Cancelled() bool
+	SetCancelled(bool)
+}
+
+type Cancel struct {
+	cancelled bool
+}
+
+func (c *Cancel) Cancelled() bool {
+	return c.cancelled
+}
+
+func (c *Cancel) SetCancelled(cancelled bool) {
+	c.cancelled = cancelled
+}
+
+type handler struct {
+	id       uint64
+	priority Priority
+	fn       func(any)
+}
+
+// Dispatches events to subscribed handlers by event type. Handlers run
+// synchronously on the goroutine that fires the event, which for most events
+// is the firing client's network goroutine.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[reflect.Type][]handler
+	lastID   uint64
+}
+
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[reflect.Type][]handler)}
+}
+
+// Subscribes fn to events of type E. Returns a function that unsubscribes it.
+func Subscribe[E any](b *Bus, priority Priority, fn func(*E)) (unsubscribe func()) {
+	t := reflect.TypeFor[E]()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.lastID++
+	id := b.lastID
+	// Fire may still be iterating over the current slice, so it is never
+	// modified in place
+	hs := append(slices.Clone(b.handlers[t]), handler{id: id, priority: priority, fn: func(e any) { fn(e.(*E)) }})
+	// Stable so handlers of equal priority run in subscription order
+	slices.SortStableFunc(hs, func(a, b handler) int { return int(a.priority) - int(b.priority) })
+	b.handlers[t] = hs
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.handlers[t] = slices.DeleteFunc(slices.Clone(b.handlers[t]), func(h handler) bool { return h.id == id })
+	}
+}
+
+// Fires an event, returning false if a handler cancelled it.
+func Fire[E any](b *Bus, e *E) bool {
+	b.mu.RLock()
+	hs := b.handlers[reflect.TypeFor[E]()]
+	b.mu.RUnlock()
+
+	for _, h := range hs {
+		call(h, e)
+	}
+
+	if c, ok := any(e).(Cancellable); ok {
+		return !c.Cancelled()
+	}
+	return true
+}
+
+// A panicking handler shouldn't take the connection down with it.
+func call(h handler, e any) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Event handler for %T panicked: %v\n%s", e, r, debug.Stack())
+		}
+	}()
+	h.fn(e)
+}
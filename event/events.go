@@ -0,0 +1,125 @@
+package event
+
+import (
+	"net"
+	"time"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/world"
+	"github.com/brenfwd/gocraft/world/block"
+	"github.com/google/uuid"
+)
+
+// A packet arrived, before it is decoded. Cancelling drops the packet. The
+// packet's body is reused afterwards, so handlers must copy it to keep it.
+type PacketReceive struct {
+	Cancel
+	RemoteAddr net.Addr
+	State      constants.ClientState
+	Packet     *network.Packet
+}
+
+// A client requested the server list status. Handlers may edit the response;
+// cancelling closes the connection without answering.
+type StatusPing struct {
+	Cancel
+	RemoteAddr net.Addr
+	Response   *data.StatusResponse
+}
+
+// A client sent Login Start, before encryption is set up. Cancelling
+// disconnects the client with KickMessage.
+type PreLogin struct {
+	Cancel
+	RemoteAddr  net.Addr
+	Username    string
+	UUID        uuid.UUID
+	KickMessage *data.Chat
+}
+
+// The client finished encryption (or its proxy forwarded its details).
+// Handlers may add login plugin requests, e.g. for a mod's handshake; Login
+// fires once the client answered all of them.
+type LoginQuery struct {
+	RemoteAddr net.Addr
+	Username   string
+	UUID       uuid.UUID
+	Requests   []LoginPluginRequest
+}
+
+// A login plugin request to send to a logging in client.
+type LoginPluginRequest struct {
+	Channel string
+	Data    []byte
+	// How long the client has to answer; zero for the default
+	Timeout time.Duration
+	// Called with the client's answer. Returning a message disconnects the
+	// client with it.
+	OnResponse func(LoginPluginResponse) *data.Chat
+}
+
+// A client's answer to a login plugin request.
+type LoginPluginResponse struct {
+	// Whether the client knows the request's channel. Clients without mods
+	// understand none.
+	Understood bool
+	Data       []byte
+	// The client didn't answer in time
+	TimedOut bool
+}
+
+// The client answered its login plugin requests and is about to be sent Login
+// Success. Cancelling disconnects the client with KickMessage.
+type Login struct {
+	Cancel
+	RemoteAddr  net.Addr
+	Username    string
+	UUID        uuid.UUID
+	KickMessage *data.Chat
+}
+
+// The player entered the play state and was added to the world.
+type Join struct {
+	Player *world.Player
+}
+
+// The player's connection closed.
+type Quit struct {
+	Player *world.Player
+}
+
+// The player sent a chat message. Handlers may change Message; cancelling
+// stops it from being broadcast.
+type Chat struct {
+	Cancel
+	Player  *world.Player
+	Message string
+}
+
+// The player moved or turned. Cancelling teleports the player back to From.
+type Move struct {
+	Cancel
+	Player *world.Player
+	From   data.Vec3
+	To     data.Vec3
+}
+
+// The player broke a block. Cancelling restores the block for the player.
+type BlockBreak struct {
+	Cancel
+	Player   *world.Player
+	Position data.Position
+	State    block.State
+}
+
+// The player used an item on a block, placing one next to it. State is the
+// block placed; it is unset until held items are tracked, so nothing is placed
+// unless a handler sets it. Cancelling removes the block again for the player.
+type BlockPlace struct {
+	Cancel
+	Player   *world.Player
+	Position data.Position
+	State    block.State
+}
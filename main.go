@@ -1,7 +1,22 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/netip"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/brenfwd/gocraft/access"
 	"github.com/brenfwd/gocraft/core"
+	"github.com/brenfwd/gocraft/network/forwarding"
+	"github.com/brenfwd/gocraft/permissions"
+	"github.com/brenfwd/gocraft/playerdata"
 )
 
 func unwrap(err error) {
@@ -10,12 +25,87 @@ func unwrap(err error) {
 	}
 }
 
+// Parses a comma-separated list of CIDRs or single addresses.
+func parsePrefixes(list string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, s := range strings.Split(list, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if addr, err := netip.ParseAddr(s); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes, nil
+}
+
 func main() {
+	captureDir := flag.String("capture", "", "directory to capture packets to, one file per connection")
+	trustedProxies := flag.String("trusted-proxies", "", "comma-separated CIDRs of load balancers that send a PROXY protocol header")
+	forwardingMode := flag.String("forwarding", "none", "how a proxy in front of the server forwards player details: none, bungeecord or velocity")
+	forwardingSecret := flag.String("forwarding-secret", "", "file holding Velocity's forwarding secret")
+	forwardingProxies := flag.String("forwarding-proxies", "", "comma-separated addresses or CIDRs of the forwarding proxies, exempt from per-IP throttling and the only sources BungeeCord forwarding is accepted from")
+	whitelist := flag.Bool("whitelist", false, "only let whitelisted players and operators join")
+	rconAddr := flag.String("rcon-addr", "0.0.0.0:25575", "address to accept RCON clients on")
+	rconPassword := flag.String("rcon-password", "", "password for RCON clients; RCON is disabled without one")
+	playerDataDir := flag.String("playerdata", "world/playerdata", "directory players are saved in")
+	flag.Parse()
 
 	server, err := core.NewServer()
 	unwrap(err)
+	server.CaptureDir = *captureDir
+	server.TrustedProxies, err = parsePrefixes(*trustedProxies)
+	unwrap(err)
+
+	switch *forwardingMode {
+	case "none":
+	case "bungeecord":
+		server.Forwarding.Mode = forwarding.ModeBungeeCord
+	case "velocity":
+		server.Forwarding.Mode = forwarding.ModeVelocity
+		secret, err := os.ReadFile(*forwardingSecret)
+		unwrap(err)
+		server.Forwarding.Secret = bytes.TrimSpace(secret)
+	default:
+		unwrap(fmt.Errorf("unknown forwarding mode %q", *forwardingMode))
+	}
+	server.Forwarding.Proxies, err = parsePrefixes(*forwardingProxies)
+	unwrap(err)
+	if server.Forwarding.Mode != forwarding.ModeNone && len(server.Forwarding.Proxies) == 0 {
+		log.Println("Warning: -forwarding-proxies is not set, so a proxy on another machine is throttled like a single client and BungeeCord forwarding is accepted from anyone")
+	}
+
+	// whitelist.json, ops.json, banned-players.json and banned-ips.json in the
+	// working directory, like vanilla
+	server.Access, err = access.Open(".")
+	unwrap(err)
+	server.Access.SetWhitelistEnabled(*whitelist)
+	server.Permissions, err = permissions.Open("permissions.json")
+	unwrap(err)
+	server.PlayerData, err = playerdata.Open(*playerDataDir)
+	unwrap(err)
+	server.RconAddr = *rconAddr
+	server.RconPassword = *rconPassword
+
+	go func() {
+		if err := server.Commands.RunConsole(os.Stdin); err != nil {
+			log.Println("Error reading console:", err)
+		}
+	}()
 
-	defer func() {
+	// Ctrl-C, or the stop command, disconnects everyone so their data is saved
+	// before Run returns
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
 		unwrap(server.Close())
 	}()
 
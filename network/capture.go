@@ -0,0 +1,115 @@
+package network
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/brenfwd/gocraft/constants"
+)
+
+type Direction int
+
+const (
+	DirectionServerbound Direction = iota
+	DirectionClientbound
+)
+
+func (d Direction) String() string {
+	if d == DirectionServerbound {
+		return "serverbound"
+	}
+	return "clientbound"
+}
+
+// One packet in a capture. Bodies are stored decrypted and decompressed.
+type CaptureRecord struct {
+	Time      time.Time                 `json:"time"`
+	Direction Direction                 `json:"direction"`
+	State     constants.ClientState     `json:"state"`
+	Protocol  constants.ProtocolVersion `json:"protocol"`
+	Id        int                       `json:"id"`
+	Body      []byte                    `json:"body"`
+}
+
+// Writes packets to a capture file, one JSON record per line. Safe for
+// concurrent use; records after Close are dropped.
+type Recorder struct {
+	mu     sync.Mutex
+	w      *bufio.Writer
+	closer io.Closer
+	closed bool
+}
+
+func NewRecorder(w io.Writer) *Recorder {
+	r := &Recorder{w: bufio.NewWriter(w)}
+	if closer, ok := w.(io.Closer); ok {
+		r.closer = closer
+	}
+	return r
+}
+
+func CreateRecorder(path string) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewRecorder(f), nil
+}
+
+func (r *Recorder) Record(direction Direction, state constants.ClientState, protocol constants.ProtocolVersion, packet *Packet) error {
+	line, err := json.Marshal(CaptureRecord{
+		Time:      time.Now(),
+		Direction: direction,
+		State:     state,
+		Protocol:  protocol,
+		Id:        packet.Id,
+		Body:      packet.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.w.Write(line)
+	return r.w.WriteByte('\n')
+}
+
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	err := r.w.Flush()
+	if r.closer != nil {
+		if closeErr := r.closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// Reads the records of a capture file in order.
+type CaptureReader struct {
+	decoder *json.Decoder
+}
+
+func NewCaptureReader(r io.Reader) *CaptureReader {
+	return &CaptureReader{decoder: json.NewDecoder(r)}
+}
+
+// Returns io.EOF after the last record.
+func (r *CaptureReader) Next() (CaptureRecord, error) {
+	var record CaptureRecord
+	err := r.decoder.Decode(&record)
+	return record, err
+}
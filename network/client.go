@@ -0,0 +1,131 @@
+package network
+
+import (
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network/encryption"
+)
+
+// The client side of a connection, e.g. for bots. Unlike Connection, packets
+// are read one at a time by the caller, so that encryption and compression can
+// be enabled between two packets.
+type ClientConnection struct {
+	inner  net.Conn
+	frames *FrameReader
+
+	writeMu   sync.Mutex
+	encrypter *encryption.Crypter
+
+	// -1 while compression is disabled
+	compressionMu        sync.RWMutex
+	compressionThreshold int
+
+	bytesWritten atomic.Int64
+}
+
+func Dial(address string) (*ClientConnection, error) {
+	conn, err := net.Dial("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	return NewClientConnection(conn), nil
+}
+
+func NewClientConnection(conn net.Conn) *ClientConnection {
+	return &ClientConnection{
+		inner:                conn,
+		frames:               NewFrameReader(conn),
+		compressionThreshold: -1,
+	}
+}
+
+func (c *ClientConnection) RemoteAddr() net.Addr {
+	return c.inner.RemoteAddr()
+}
+
+func (c *ClientConnection) SetDeadline(t time.Time) error {
+	return c.inner.SetDeadline(t)
+}
+
+// Bytes received and sent on the wire so far, including framing.
+func (c *ClientConnection) BytesRead() int64 {
+	return c.frames.BytesRead()
+}
+
+func (c *ClientConnection) BytesWritten() int64 {
+	return c.bytesWritten.Load()
+}
+
+func (c *ClientConnection) Close() error {
+	return c.inner.Close()
+}
+
+// Enables encryption in both directions, after sending Encryption Response.
+// Must be called from the goroutine reading packets.
+func (c *ClientConnection) EnableEncryption(sharedSecret []byte) error {
+	decrypter, err := encryption.NewCrypter(sharedSecret)
+	if err != nil {
+		return err
+	}
+	encrypter, err := encryption.NewCrypter(sharedSecret)
+	if err != nil {
+		return err
+	}
+	c.frames.SetDecrypter(decrypter)
+	c.writeMu.Lock()
+	c.encrypter = encrypter
+	c.writeMu.Unlock()
+	return nil
+}
+
+// Enables compression in both directions, after receiving Set Compression. A
+// negative threshold disables it again.
+func (c *ClientConnection) SetCompression(threshold int) {
+	c.compressionMu.Lock()
+	c.compressionThreshold = threshold
+	c.compressionMu.Unlock()
+}
+
+func (c *ClientConnection) compression() int {
+	c.compressionMu.RLock()
+	defer c.compressionMu.RUnlock()
+	return c.compressionThreshold
+}
+
+// Reads the next packet. Must not be called from multiple goroutines.
+func (c *ClientConnection) ReadPacket() (Packet, error) {
+	frame, err := c.frames.ReadFrame(nil)
+	if err != nil {
+		return Packet{}, err
+	}
+	return UnmarshalFrame(frame, c.compression() >= 0)
+}
+
+// Writes a packet. Safe to call from multiple goroutines.
+func (c *ClientConnection) WritePacket(packet *Packet) error {
+	buf := getFrameBuffer()
+	defer putFrameBuffer(buf)
+	frame, err := packet.AppendFrame(*buf, c.compression())
+	*buf = frame
+	if err != nil {
+		return err
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if c.encrypter != nil {
+		c.encrypter.Encrypt(&frame)
+	}
+	n, err := c.inner.Write(frame)
+	c.bytesWritten.Add(int64(n))
+	return err
+}
+
+// Convenience for building packet bodies by hand.
+func NewPacket(id int, body *data.Buffer) Packet {
+	return Packet{Id: id, Body: body.Raw}
+}
@@ -7,99 +7,330 @@ import (
 	"io"
 	"log"
 	"net"
+	"sync"
+	"time"
 
+	"github.com/brenfwd/gocraft/constants"
 	"github.com/brenfwd/gocraft/network/encryption"
 )
 
+// The server side of a connection. Packets are read by Receive into Packets.
+// Written packets are queued, and sent by a writer goroutine on Flush.
 type Connection struct {
-	inner        net.Conn
-	eofSend      chan<- bool
-	Eof          <-chan bool
-	packetsSend  chan<- Packet
-	Packets      <-chan Packet
-	unmarshaller PacketUnmarshaller
-	Keypair      *encryption.KeypairBytes
-	crypter      *encryption.Crypter
+	inner       net.Conn
+	eofSend     chan<- bool
+	Eof         <-chan bool
+	packetsSend chan<- Packet
+	Packets     <-chan Packet
+	frames      *FrameReader
+	Keypair     *encryption.KeypairBytes
+	recorder    *Recorder
+	limits      WriteLimits
+
+	// Set by the listener's Throttler
+	packetLimit *tokenBucket
+	onFlood     func()
+	loginTimer  *time.Timer
+	onClose     func()
+
+	// Guards everything below. cond is signalled when the writer goes idle or
+	// exits.
+	mu   sync.Mutex
+	cond *sync.Cond
+	// Frames waiting to be written, by priority
+	lanes   [2][]*[]byte
+	queued  int
+	writing bool
+	// When the queue went over limits.MaxQueued, zero if it isn't
+	overSince time.Time
+	crypter   *encryption.Crypter
+	closed    bool
+	err       error
+
+	wake       chan struct{}
+	writerDone chan struct{}
+}
+
+type Priority int
+
+const (
+	PriorityNormal Priority = iota
+	// Sent ahead of queued normal packets, for keep alives and disconnects
+	// that must not wait behind chunks
+	PriorityHigh
+)
+
+// Limits of a connection's write queue.
+type WriteLimits struct {
+	// Bytes queued above which a client is considered slow
+	MaxQueued int
+	// How long a client may stay above MaxQueued before it is disconnected.
+	// Clients above four times MaxQueued are disconnected right away.
+	SlowTimeout time.Duration
+	// Deadline for each write to the socket
+	WriteTimeout time.Duration
+}
+
+// Limits of new connections.
+var DefaultWriteLimits = WriteLimits{
+	MaxQueued:    4 << 20,
+	SlowTimeout:  10 * time.Second,
+	WriteTimeout: 30 * time.Second,
 }
 
-func MakeConnection(inner net.Conn, keypair *encryption.KeypairBytes) Connection {
+var ErrSlowClient = errors.New("client is not reading fast enough")
+
+// How long queued packets (e.g. a disconnect) have to go out on Close.
+const closeTimeout = time.Second
+
+func NewConnection(inner net.Conn, keypair *encryption.KeypairBytes) *Connection {
 	eof := make(chan bool, 10)
 	packets := make(chan Packet, 10)
-	return Connection{inner: inner,
+	c := &Connection{inner: inner,
 		eofSend:     eof,
 		Eof:         eof,
 		packetsSend: packets,
 		Packets:     packets,
+		frames:      NewFrameReader(inner),
 		Keypair:     keypair,
-		crypter:     nil,
+		limits:      DefaultWriteLimits,
+		wake:        make(chan struct{}, 1),
+		writerDone:  make(chan struct{}),
 	}
+	c.cond = sync.NewCond(&c.mu)
+	go c.writeLoop()
+	return c
 }
 
 func (c *Connection) RemoteAddr() net.Addr {
 	return c.inner.RemoteAddr()
 }
 
+// Closes the connection once queued packets have been sent, waiting at most a
+// second for them. Closing again does nothing.
 func (c *Connection) Close() error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	c.mu.Unlock()
+
+	if c.loginTimer != nil {
+		c.loginTimer.Stop()
+	}
+	if c.onClose != nil {
+		c.onClose()
+	}
+	if c.recorder != nil {
+		c.recorder.Close()
+	}
+	c.inner.SetWriteDeadline(time.Now().Add(closeTimeout))
+	c.Flush()
+	<-c.writerDone
 	err := c.inner.Close()
 	c.eofSend <- true
 	return err
 }
 
-// Sets the crypter for the connection. When this is set, all data sent and received will be encrypted/decrypted.
+// Sets the crypter for the connection. When this is set, all data sent and
+// received will be encrypted/decrypted. Waits for packets queued before to be
+// sent unencrypted.
 func (c *Connection) SetCrypter(crypter *encryption.Crypter) {
+	c.frames.SetDecrypter(crypter)
+	c.Flush()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for (c.queued > 0 || c.writing) && c.err == nil && !c.closed {
+		c.cond.Wait()
+	}
 	c.crypter = crypter
 }
 
-func (c *Connection) WriteBytes(bytes []byte) error {
-	if c.crypter != nil {
-		c.crypter.Encrypt(&bytes)
+// Stops the login timeout, once the client has logged in.
+func (c *Connection) LoginComplete() {
+	if c.loginTimer != nil {
+		c.loginTimer.Stop()
 	}
-	_, err := c.inner.Write(bytes)
-	return err
 }
 
-func (c *Connection) WritePacket(packet *Packet) error {
-	bytes, err := packet.Marshal()
+// Sets a recorder that packets are captured to, see Record. It is closed with
+// the connection.
+func (c *Connection) SetRecorder(recorder *Recorder) {
+	c.recorder = recorder
+}
+
+// Captures a packet if a recorder is set. Packets are recorded by the code
+// handling them rather than when they are read, since only that knows which
+// state they belong to.
+func (c *Connection) Record(direction Direction, state constants.ClientState, protocol constants.ProtocolVersion, packet *Packet) {
+	if c.recorder == nil {
+		return
+	}
+	if err := c.recorder.Record(direction, state, protocol, packet); err != nil {
+		log.Println("Error recording packet:", err)
+	}
+}
+
+// Queues a packet, to be sent with the next Flush. Returns ErrSlowClient if the
+// client has fallen too far behind, after which the connection should be
+// closed. Safe to call from multiple goroutines.
+func (c *Connection) WritePacket(packet *Packet, priority Priority) error {
+	buf := getFrameBuffer()
+	frame, err := packet.AppendFrame(*buf, -1)
+	*buf = frame
 	if err != nil {
+		putFrameBuffer(buf)
 		return err
 	}
-	return c.WriteBytes(bytes)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil || c.closed {
+		putFrameBuffer(buf)
+		if c.err != nil {
+			return c.err
+		}
+		return net.ErrClosed
+	}
+	c.lanes[priority] = append(c.lanes[priority], buf)
+	c.queued += len(frame)
+
+	if c.queued > c.limits.MaxQueued {
+		now := time.Now()
+		if c.overSince.IsZero() {
+			c.overSince = now
+		}
+		if c.queued > 4*c.limits.MaxQueued || now.Sub(c.overSince) > c.limits.SlowTimeout {
+			c.err = fmt.Errorf("%w: %d bytes queued", ErrSlowClient, c.queued)
+			return c.err
+		}
+	}
+	return nil
 }
 
-func (c *Connection) Receive() {
-	reader := bufio.NewReader(c.inner)
-	buf := make([]byte, 4096)
+// Wakes the writer to send the queued packets. Packets queued in a row (e.g.
+// everything sent to a client in one tick) go out in a single write. Returns
+// the error that stopped the writer, if any.
+func (c *Connection) Flush() error {
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
 
+// Bytes queued and not yet sent.
+func (c *Connection) Queued() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.queued
+}
+
+func (c *Connection) writeLoop() {
+	defer close(c.writerDone)
+	writer := bufio.NewWriterSize(c.inner, 32*1024)
+	var batch []*[]byte
+	for range c.wake {
+		c.mu.Lock()
+		// Higher priorities first
+		for i := len(c.lanes) - 1; i >= 0; i-- {
+			batch = append(batch, c.lanes[i]...)
+			clear(c.lanes[i])
+			c.lanes[i] = c.lanes[i][:0]
+		}
+		c.writing = len(batch) > 0
+		crypter := c.crypter
+		closed := c.closed
+		c.mu.Unlock()
+
+		var err error
+		if len(batch) > 0 {
+			if !closed {
+				c.inner.SetWriteDeadline(time.Now().Add(c.limits.WriteTimeout))
+			}
+			err = writeFrames(writer, batch, crypter)
+		}
+
+		c.mu.Lock()
+		for _, buf := range batch {
+			c.queued -= len(*buf)
+			putFrameBuffer(buf)
+		}
+		clear(batch)
+		batch = batch[:0]
+		if c.queued <= c.limits.MaxQueued {
+			c.overSince = time.Time{}
+		}
+		if err != nil && c.err == nil {
+			c.err = err
+		}
+		c.writing = false
+		stop := c.err != nil || (c.closed && c.queued == 0)
+		c.cond.Broadcast()
+		c.mu.Unlock()
+
+		if err != nil {
+			// Unblocks Receive, which closes the connection
+			c.inner.Close()
+		}
+		if stop {
+			return
+		}
+	}
+}
+
+func writeFrames(writer *bufio.Writer, frames []*[]byte, crypter *encryption.Crypter) error {
+	for _, buf := range frames {
+		if crypter != nil {
+			crypter.Encrypt(buf)
+		}
+		if _, err := writer.Write(*buf); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+// Reads packets into Packets until the connection is closed. Their bodies are
+// pooled: call Packet.Release once a packet has been handled.
+func (c *Connection) Receive() {
 	for {
-		n, err := reader.Read(buf)
+		buf := getFrameBuffer()
+		frame, err := c.frames.ReadFrame(*buf)
+		*buf = frame
 		if err != nil {
-			if errors.Is(err, net.ErrClosed) {
+			putFrameBuffer(buf)
+			if errors.Is(err, ErrInvalidFrame) {
+				log.Println("Error reading packet:", err, c.inner.RemoteAddr())
+			} else if errors.Is(err, net.ErrClosed) {
 				log.Println("Connection closed by remote host", c.inner.RemoteAddr())
-				c.Close()
+			} else if !errors.Is(err, io.EOF) {
+				log.Println("Read error:", err, c.inner.RemoteAddr())
 			}
-			if !errors.Is(err, io.EOF) {
-				log.Println("reader.Read error:", err, c.inner.RemoteAddr())
-			}
-			// c.eofSend <- true
 			c.Close()
 			return
 		}
-		if n != 0 {
-			if c.crypter != nil {
-				c.crypter.Decrypt(&buf)
-			}
-			// c.packetsSend <- Packet{Data: buf[0:n]}
-			log.Printf("Received buffer: %x [%#v]", buf[0:n], string(buf[0:n]))
-			packets, err := c.unmarshaller.Unmarshal(buf[0:n])
-			if err != nil {
-				fmt.Println("Error during unmarshal:", err)
-				// c.eofSend <- true
-				c.Close()
-				return
-			}
-			for _, packet := range packets {
-				c.packetsSend <- packet
-			}
+
+		if c.packetLimit != nil && !c.packetLimit.take(time.Now()) {
+			putFrameBuffer(buf)
+			log.Println("Packet flood from", c.inner.RemoteAddr())
+			c.onFlood()
+			c.Close()
+			return
+		}
+
+		packet, err := UnmarshalFrame(frame, false)
+		if err != nil {
+			putFrameBuffer(buf)
+			log.Println("Error reading packet:", err, c.inner.RemoteAddr())
+			c.Close()
+			return
 		}
+		packet.pooled = buf
+		c.packetsSend <- packet
 	}
 }
@@ -0,0 +1,165 @@
+package network
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/brenfwd/gocraft/network/encryption"
+)
+
+// Packets queued per Flush in the write benchmarks, about what a player gets
+// in a tick. The next batch is only queued once the last one was written.
+const benchmarkTickPackets = 32
+
+var benchmarkSizes = []struct {
+	name string
+	size int
+}{
+	// An entity movement or position update
+	{"Small", 32},
+	// A chunk column
+	{"Chunk", 100 << 10},
+}
+
+var benchmarkSecret = []byte("0123456789abcdef")
+
+// A connected pair of TCP sockets on the loopback interface.
+func loopbackPair(b *testing.B) (server, client net.Conn) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer l.Close()
+	client, err = net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		b.Fatal(err)
+	}
+	if server, err = l.Accept(); err != nil {
+		b.Fatal(err)
+	}
+	return server, client
+}
+
+func BenchmarkConnectionWrite(b *testing.B) {
+	for _, encrypted := range []bool{false, true} {
+		for _, bs := range benchmarkSizes {
+			name := bs.name
+			if encrypted {
+				name += "Encrypted"
+			}
+			b.Run(name, func(b *testing.B) {
+				benchmarkWrite(b, bs.size, encrypted)
+			})
+		}
+	}
+}
+
+func benchmarkWrite(b *testing.B, size int, encrypted bool) {
+	server, client := loopbackPair(b)
+	defer client.Close()
+	go io.Copy(io.Discard, client)
+
+	c := NewConnection(server, nil)
+	defer c.Close()
+	if encrypted {
+		crypter, err := encryption.NewCrypter(benchmarkSecret)
+		if err != nil {
+			b.Fatal(err)
+		}
+		c.SetCrypter(crypter)
+	}
+
+	packet := Packet{Id: 0x27, Body: make([]byte, size)}
+	b.SetBytes(int64(size))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range b.N {
+		if err := c.WritePacket(&packet, PriorityNormal); err != nil {
+			b.Fatal(err)
+		}
+		if i%benchmarkTickPackets == benchmarkTickPackets-1 {
+			flushAndWait(c)
+		}
+	}
+	flushAndWait(c)
+}
+
+// Sends the queued packets and waits until they are written, like a tick
+// would before queueing the next batch.
+func flushAndWait(c *Connection) {
+	c.Flush()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for (c.queued > 0 || c.writing) && c.err == nil {
+		c.cond.Wait()
+	}
+}
+
+func BenchmarkConnectionReceive(b *testing.B) {
+	for _, encrypted := range []bool{false, true} {
+		for _, bs := range benchmarkSizes {
+			name := bs.name
+			if encrypted {
+				name += "Encrypted"
+			}
+			b.Run(name, func(b *testing.B) {
+				benchmarkReceive(b, bs.size, encrypted)
+			})
+		}
+	}
+}
+
+func benchmarkReceive(b *testing.B, size int, encrypted bool) {
+	server, client := loopbackPair(b)
+
+	packet := Packet{Id: 0x1a, Body: make([]byte, size)}
+	frame, err := packet.AppendFrame(nil, -1)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	c := NewConnection(server, nil)
+	var encrypter *encryption.Crypter
+	if encrypted {
+		crypter, err := encryption.NewCrypter(benchmarkSecret)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if encrypter, err = encryption.NewCrypter(benchmarkSecret); err != nil {
+			b.Fatal(err)
+		}
+		c.SetCrypter(crypter)
+	}
+	go c.Receive()
+
+	b.SetBytes(int64(size))
+	b.ReportAllocs()
+	b.ResetTimer()
+	go func() {
+		w := bufio.NewWriterSize(client, 32*1024)
+		buf := make([]byte, len(frame))
+		for range b.N {
+			copy(buf, frame)
+			if encrypter != nil {
+				encrypter.Encrypt(&buf)
+			}
+			if _, err := w.Write(buf); err != nil {
+				return
+			}
+		}
+		w.Flush()
+	}()
+	for range b.N {
+		p := <-c.Packets
+		if len(p.Body) != size {
+			b.Fatalf("got a %d byte body, want %d", len(p.Body), size)
+		}
+		p.Release()
+	}
+	b.StopTimer()
+	// Ends Receive with an EOF, which closes the connection
+	client.Close()
+	<-c.Eof
+}
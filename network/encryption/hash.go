@@ -0,0 +1,36 @@
+package encryption
+
+import (
+	"crypto/sha1"
+	"math/big"
+)
+
+// The server hash used to authenticate with the session server. It's the SHA-1
+// of the server ID, shared secret and public key, printed as a signed
+// (two's complement) hexadecimal number like Java's BigInteger.toString(16).
+func ServerHash(serverID string, sharedSecret []byte, publicKey []byte) string {
+	h := sha1.New()
+	h.Write([]byte(serverID))
+	h.Write(sharedSecret)
+	h.Write(publicKey)
+	sum := h.Sum(nil)
+
+	negative := sum[0]&0x80 != 0
+	if negative {
+		// Two's complement
+		carry := true
+		for i := len(sum) - 1; i >= 0; i-- {
+			sum[i] = ^sum[i]
+			if carry {
+				sum[i]++
+				carry = sum[i] == 0
+			}
+		}
+	}
+
+	hex := new(big.Int).SetBytes(sum).Text(16)
+	if negative {
+		return "-" + hex
+	}
+	return hex
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"fmt"
 	"log"
 )
 
@@ -49,3 +50,23 @@ func (kp *KeypairBytes) DecryptWithPrivateKey(data *[]byte) error {
 	*data = decrypted
 	return nil
 }
+
+// Encrypts data **in-place** with a server public key (PKIX DER, as sent in
+// Encryption Request) using `rsa.EncryptPKCS1v15`. Used by clients.
+func EncryptWithPublicKey(publicKey []byte, data *[]byte) error {
+	parsed, err := x509.ParsePKIXPublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	rsaKey, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("public key is %T, not RSA", parsed)
+	}
+	encrypted, err := rsa.EncryptPKCS1v15(rand.Reader, rsaKey, *data)
+	if err != nil {
+		return err
+	}
+
+	*data = encrypted
+	return nil
+}
@@ -0,0 +1,188 @@
+package forwarding
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"strings"
+
+	"github.com/brenfwd/gocraft/data"
+	"github.com/google/uuid"
+)
+
+// How a proxy in front of the server passes on the details of the players it
+// authenticated.
+type Mode int
+
+const (
+	ModeNone Mode = iota
+	// BungeeCord's legacy forwarding: the details are appended to the
+	// handshake's server address. Anyone who can reach the server directly can
+	// claim to be anyone, so it must only be reachable through the proxy.
+	ModeBungeeCord
+	// Velocity's modern forwarding: the details are sent in answer to a login
+	// plugin request, signed with a secret shared with the proxy.
+	ModeVelocity
+)
+
+type Config struct {
+	Mode Mode
+	// The forwarding secret configured in Velocity
+	Secret []byte
+	// Addresses of the proxies. Every player connects through them, so the
+	// server exempts them from its per-IP throttling. If set, BungeeCord
+	// forwarding is only accepted from them.
+	Proxies []netip.Prefix
+}
+
+// Whether addr may forward player details. Any address may if no proxies are
+// configured.
+func (c *Config) FromProxy(addr netip.Addr) bool {
+	if len(c.Proxies) == 0 {
+		return true
+	}
+	for _, prefix := range c.Proxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	ErrNotForwarded     = errors.New("connection was not forwarded by the proxy")
+	ErrInvalidSignature = errors.New("invalid forwarding signature")
+)
+
+// A profile property, e.g. the player's skin ("textures").
+type Property struct {
+	Name      string  `json:"name"`
+	Value     string  `json:"value"`
+	Signature *string `json:"signature,omitempty" message:"optional"`
+}
+
+// The player details a proxy forwarded.
+type PlayerInfo struct {
+	Address netip.Addr
+	UUID    uuid.UUID
+	// Only forwarded by Velocity
+	Username   string
+	Properties []Property
+}
+
+// Splits a BungeeCord handshake server address
+// ("host\x00address\x00uuid\x00properties") into the original host and the
+// forwarded details.
+func ParseBungeeCord(serverAddress string) (string, *PlayerInfo, error) {
+	parts := strings.Split(serverAddress, "\x00")
+	if len(parts) < 3 {
+		return "", nil, ErrNotForwarded
+	}
+	address, err := netip.ParseAddr(parts[1])
+	if err != nil {
+		return "", nil, fmt.Errorf("forwarded address: %w", err)
+	}
+	// Without dashes
+	id, err := uuid.Parse(parts[2])
+	if err != nil {
+		return "", nil, fmt.Errorf("forwarded UUID: %w", err)
+	}
+	info := &PlayerInfo{Address: address.Unmap(), UUID: id}
+	if len(parts) > 3 && parts[3] != "" {
+		if err := json.Unmarshal([]byte(parts[3]), &info.Properties); err != nil {
+			return "", nil, fmt.Errorf("forwarded properties: %w", err)
+		}
+	}
+	return parts[0], info, nil
+}
+
+// Appends the player's details to a handshake server address, as BungeeCord
+// does. The reverse of ParseBungeeCord.
+func BungeeCordAddress(host string, info *PlayerInfo) (string, error) {
+	properties, err := json.Marshal(info.Properties)
+	if err != nil {
+		return "", err
+	}
+	if info.Properties == nil {
+		properties = []byte("[]")
+	}
+	id := strings.ReplaceAll(info.UUID.String(), "-", "")
+	return strings.Join([]string{host, info.Address.String(), id, string(properties)}, "\x00"), nil
+}
+
+const (
+	// Login plugin channel Velocity answers with the player's details
+	VelocityChannel = "velocity:player_info"
+	// Forwarding version requested from Velocity; later versions add the
+	// player's chat signing key, which the server doesn't use
+	velocityVersion = 1
+)
+
+// The body of the login plugin request on VelocityChannel.
+func VelocityRequest() []byte {
+	return []byte{velocityVersion}
+}
+
+type velocityPlayerInfo struct {
+	Version    data.VarInt
+	Address    string
+	UUID       uuid.UUID
+	Username   string
+	Properties []Property `message:"length:varint"`
+}
+
+// Checks the signature of Velocity's answer on VelocityChannel, and reads the
+// details in it.
+func ParseVelocity(secret []byte, response []byte) (*PlayerInfo, error) {
+	if len(response) < sha256.Size {
+		return nil, ErrInvalidSignature
+	}
+	signature, payload := response[:sha256.Size], response[sha256.Size:]
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var forwarded velocityPlayerInfo
+	buf := data.NewBufferFromBytes(payload)
+	if err := buf.ReadFields(reflect.ValueOf(&forwarded).Elem()); err != nil {
+		return nil, err
+	}
+	if forwarded.Version < velocityVersion {
+		return nil, fmt.Errorf("unsupported forwarding version %d", forwarded.Version)
+	}
+	address, err := netip.ParseAddr(forwarded.Address)
+	if err != nil {
+		return nil, fmt.Errorf("forwarded address: %w", err)
+	}
+	return &PlayerInfo{
+		Address:    address.Unmap(),
+		UUID:       forwarded.UUID,
+		Username:   forwarded.Username,
+		Properties: forwarded.Properties,
+	}, nil
+}
+
+// Velocity's answer on VelocityChannel: the player's details, signed with the
+// secret. The reverse of ParseVelocity.
+func VelocityResponse(secret []byte, info *PlayerInfo) ([]byte, error) {
+	var buf data.Buffer
+	err := buf.WriteFields(reflect.ValueOf(velocityPlayerInfo{
+		Version:    velocityVersion,
+		Address:    info.Address.String(),
+		UUID:       info.UUID,
+		Username:   info.Username,
+		Properties: info.Properties,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(buf.Raw)
+	return append(mac.Sum(nil), buf.Raw...), nil
+}
@@ -0,0 +1,184 @@
+package forwarding
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/brenfwd/gocraft/data"
+	"github.com/google/uuid"
+)
+
+var testSecret = []byte("forwarding secret")
+
+func testInfo() *PlayerInfo {
+	signature := "c2lnbmF0dXJl"
+	return &PlayerInfo{
+		Address:  netip.MustParseAddr("192.0.2.1"),
+		UUID:     uuid.MustParse("069a79f4-44e9-4726-a5be-fca90e38aaf5"),
+		Username: "Notch",
+		Properties: []Property{
+			{Name: "textures", Value: "eyJ0ZXh0dXJlcyI6e319", Signature: &signature},
+			{Name: "unsigned", Value: "value"},
+		},
+	}
+}
+
+func TestBungeeCordRoundTrip(t *testing.T) {
+	want := testInfo()
+	address, err := BungeeCordAddress("play.example.com", want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, got, err := ParseBungeeCord(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if host != "play.example.com" {
+		t.Errorf("got host %q, want play.example.com", host)
+	}
+	// BungeeCord doesn't forward the username
+	want.Username = ""
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestParseBungeeCord(t *testing.T) {
+	const id = "069a79f444e94726a5befca90e38aaf5"
+	tests := []struct {
+		name    string
+		address string
+		want    *PlayerInfo
+		err     error
+	}{
+		{"NoProperties", "host\x00192.0.2.1\x00" + id, &PlayerInfo{
+			Address: netip.MustParseAddr("192.0.2.1"),
+			UUID:    uuid.MustParse(id),
+		}, nil},
+		{"EmptyProperties", "host\x002001:db8::1\x00" + id + "\x00", &PlayerInfo{
+			Address: netip.MustParseAddr("2001:db8::1"),
+			UUID:    uuid.MustParse(id),
+		}, nil},
+		{"MappedAddress", "host\x00::ffff:192.0.2.1\x00" + id + "\x00[]", &PlayerInfo{
+			Address:    netip.MustParseAddr("192.0.2.1"),
+			UUID:       uuid.MustParse(id),
+			Properties: []Property{},
+		}, nil},
+
+		{"NotForwarded", "play.example.com", nil, ErrNotForwarded},
+		{"OnlyAddress", "host\x00192.0.2.1", nil, ErrNotForwarded},
+		{"BadAddress", "host\x00not an address\x00" + id, nil, nil},
+		{"BadUUID", "host\x00192.0.2.1\x00not a uuid", nil, nil},
+		{"BadProperties", "host\x00192.0.2.1\x00" + id + "\x00{", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got, err := ParseBungeeCord(tt.address)
+			if tt.want == nil {
+				if err == nil {
+					t.Fatalf("parsed %+v, want an error", got)
+				}
+				if tt.err != nil && !errors.Is(err, tt.err) {
+					t.Fatalf("got error %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVelocityRoundTrip(t *testing.T) {
+	want := testInfo()
+	response, err := VelocityResponse(testSecret, want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseVelocity(testSecret, response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+// Signs a payload with the secret, like Velocity.
+func sign(secret, payload []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return append(mac.Sum(nil), payload...)
+}
+
+func TestParseVelocityErrors(t *testing.T) {
+	response, err := VelocityResponse(testSecret, testInfo())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := append([]byte{}, response...)
+	tampered[len(tampered)-1] ^= 1
+
+	var old data.Buffer
+	old.WriteFields(reflect.ValueOf(velocityPlayerInfo{Version: 0, Address: "192.0.2.1", Username: "Notch"}))
+
+	tests := []struct {
+		name     string
+		secret   []byte
+		response []byte
+		err      error
+	}{
+		{"WrongSecret", []byte("another secret"), response, ErrInvalidSignature},
+		{"Tampered", testSecret, tampered, ErrInvalidSignature},
+		{"Unsigned", testSecret, response[sha256.Size:], ErrInvalidSignature},
+		{"Short", testSecret, response[:sha256.Size-1], ErrInvalidSignature},
+		{"Empty", testSecret, nil, ErrInvalidSignature},
+		{"Truncated", testSecret, sign(testSecret, response[sha256.Size:sha256.Size+10]), nil},
+		{"OldVersion", testSecret, sign(testSecret, old.Raw), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVelocity(tt.secret, tt.response)
+			if err == nil {
+				t.Fatalf("parsed %+v, want an error", got)
+			}
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Fatalf("got error %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestFromProxy(t *testing.T) {
+	anyone := Config{Mode: ModeBungeeCord}
+	if !anyone.FromProxy(netip.MustParseAddr("192.0.2.1")) {
+		t.Error("rejected an address with no proxies configured")
+	}
+
+	c := Config{Mode: ModeBungeeCord, Proxies: []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("2001:db8::5/128"),
+	}}
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"10.1.2.3", true},
+		{"2001:db8::5", true},
+		{"192.0.2.1", false},
+		{"2001:db8::6", false},
+	}
+	for _, tt := range tests {
+		if got := c.FromProxy(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("FromProxy(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package network
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"slices"
+	"sync/atomic"
+
+	"github.com/brenfwd/gocraft/network/encryption"
+)
+
+var ErrInvalidFrame = errors.New("invalid packet length")
+
+// Reads length-prefixed frames from a stream. Bytes are decrypted as they are
+// consumed, so data buffered before encryption was enabled is still decrypted
+// correctly.
+type FrameReader struct {
+	reader    *bufio.Reader
+	decrypter atomic.Pointer[encryption.Crypter]
+	bytesRead atomic.Int64
+	// Scratch space for decrypting the length prefix a byte at a time
+	one []byte
+}
+
+func NewFrameReader(r io.Reader) *FrameReader {
+	return &FrameReader{
+		reader: bufio.NewReaderSize(r, 16*1024),
+		one:    make([]byte, 1),
+	}
+}
+
+// Decrypts the bytes read from now on. Safe to call while another goroutine is
+// reading.
+func (r *FrameReader) SetDecrypter(decrypter *encryption.Crypter) {
+	r.decrypter.Store(decrypter)
+}
+
+// Bytes read from the stream so far, including length prefixes.
+func (r *FrameReader) BytesRead() int64 {
+	return r.bytesRead.Load()
+}
+
+// Reads the next frame, without its length prefix, appending it to dst. Must not
+// be called from multiple goroutines.
+func (r *FrameReader) ReadFrame(dst []byte) ([]byte, error) {
+	length, err := r.readLength()
+	if err != nil {
+		return dst, err
+	}
+	if length < 1 || length > MaxPacketLength {
+		return dst, ErrInvalidFrame
+	}
+
+	start := len(dst)
+	dst = slices.Grow(dst, length)[:start+length]
+	frame := dst[start:]
+	n, err := io.ReadFull(r.reader, frame)
+	r.bytesRead.Add(int64(n))
+	if err != nil {
+		return dst[:start], err
+	}
+	if decrypter := r.decrypter.Load(); decrypter != nil {
+		decrypter.Decrypt(&frame)
+	}
+	return dst, nil
+}
+
+func (r *FrameReader) readLength() (int, error) {
+	var value int
+	for i := 0; ; i++ {
+		if i >= 3 {
+			// Lengths are limited to 21 bits
+			return 0, ErrInvalidFrame
+		}
+		b, err := r.reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		r.bytesRead.Add(1)
+		if decrypter := r.decrypter.Load(); decrypter != nil {
+			r.one[0] = b
+			decrypter.Decrypt(&r.one)
+			b = r.one[0]
+		}
+		value |= int(b&0x7F) << (7 * i)
+		if b&0x80 == 0 {
+			return value, nil
+		}
+	}
+}
+
+func appendVarInt(dst []byte, v int) []byte {
+	u := uint32(v)
+	for u >= 0x80 {
+		dst = append(dst, byte(u)|0x80)
+		u >>= 7
+	}
+	return append(dst, byte(u))
+}
+
+func varIntSize(v int) int {
+	u := uint32(v)
+	size := 1
+	for u >= 0x80 {
+		u >>= 7
+		size++
+	}
+	return size
+}
@@ -5,15 +5,25 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/netip"
+	"sync"
 
 	"github.com/brenfwd/gocraft/network/encryption"
 )
 
 type Listener struct {
 	inner         net.Listener
-	incoming_send chan<- Connection
-	Incoming      <-chan Connection
+	incoming_send chan<- *Connection
+	Incoming      <-chan *Connection
 	keypair       *encryption.KeypairBytes
+	// Limits incoming connections, if set. Must be set before Listen.
+	Throttle *Throttler
+	// Connections from these addresses must start with a PROXY protocol header,
+	// whose client address is used in place of theirs. Must be set before
+	// Listen.
+	TrustedProxies []netip.Prefix
+	// Connections still waiting for their PROXY protocol header
+	pending *sync.WaitGroup
 }
 
 func NewListener(host string, port uint16) (Listener, error) {
@@ -22,7 +32,7 @@ func NewListener(host string, port uint16) (Listener, error) {
 		return Listener{}, err
 	}
 
-	c := make(chan Connection, 256) // backlog
+	c := make(chan *Connection, 256) // backlog
 
 	kp, err := encryption.MakeKeypairBytes()
 	if err != nil {
@@ -34,6 +44,7 @@ func NewListener(host string, port uint16) (Listener, error) {
 		incoming_send: c,
 		Incoming:      c,
 		keypair:       &kp,
+		pending:       &sync.WaitGroup{},
 	}, nil
 }
 
@@ -41,7 +52,14 @@ func (l *Listener) Close() error {
 	return l.inner.Close()
 }
 
+func (l *Listener) Addr() net.Addr {
+	return l.inner.Addr()
+}
+
+// Accepts connections until the listener is closed, then closes Incoming.
 func (l *Listener) Listen() {
+	defer close(l.incoming_send)
+	defer l.pending.Wait()
 	for {
 		netConn, err := l.inner.Accept()
 		if err != nil {
@@ -49,8 +67,50 @@ func (l *Listener) Listen() {
 				return
 			}
 			log.Println("Error during Listener.Listen Accept call:", err)
+			continue
+		}
+		if l.trusted(AddrIP(netConn.RemoteAddr())) {
+			// Reading the header may take a while, so it mustn't hold up other
+			// connections
+			l.pending.Add(1)
+			go func() {
+				defer l.pending.Done()
+				proxied, err := readProxyHeader(netConn)
+				if err != nil {
+					log.Println("Error reading PROXY protocol header:", err, netConn.RemoteAddr())
+					netConn.Close()
+					return
+				}
+				l.accept(proxied)
+			}()
+			continue
+		}
+		l.accept(netConn)
+	}
+}
+
+func (l *Listener) trusted(addr netip.Addr) bool {
+	for _, prefix := range l.TrustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *Listener) accept(netConn net.Conn) {
+	addr := AddrIP(netConn.RemoteAddr())
+	// Connections a trusted proxy makes itself (e.g. health checks) aren't
+	// counted against it
+	if l.Throttle != nil && !l.trusted(addr) {
+		if err := l.Throttle.admit(addr); err != nil {
+			netConn.Close()
+			return
 		}
-		conn := MakeConnection(netConn, l.keypair)
-		l.incoming_send <- conn
 	}
+	conn := NewConnection(netConn, l.keypair)
+	if l.Throttle != nil {
+		l.Throttle.attach(conn, addr)
+	}
+	l.incoming_send <- conn
 }
@@ -1,161 +1,194 @@
 package messages
 
+//go:generate go run ./gen -protocols protocol
+
 import (
+	"errors"
 	"fmt"
-	"log"
 	"reflect"
-	"strings"
 
 	"github.com/brenfwd/gocraft/constants"
 	"github.com/brenfwd/gocraft/data"
 	"github.com/brenfwd/gocraft/network"
-	"github.com/brenfwd/gocraft/shared"
 )
 
-type ServerboundInterface interface {
-	Handle(*shared.ClientShared) error
-}
-
 type Serverbound struct{}
 
 type Clientbound struct{}
 
-// Serverbound message registry
-type serverboundRegistryKey struct {
+// Both directions are registered in two maps: by ID for decoding, and by type
+// for encoding. The server decodes serverbound and encodes clientbound
+// messages, clients the other way around.
+type registryKey struct {
+	Protocol constants.ProtocolVersion
+	State    constants.ClientState
+	Id       int
+}
+
+type registryValue struct {
 	State constants.ClientState
 	Id    int
 }
 
-var serverboundRegistry = make(map[serverboundRegistryKey]reflect.Type)
+type registry struct {
+	byID   map[registryKey]reflect.Type
+	byType map[reflect.Type]map[constants.ProtocolVersion]registryValue
+}
+
+func newRegistry() *registry {
+	return &registry{
+		byID:   make(map[registryKey]reflect.Type),
+		byType: make(map[reflect.Type]map[constants.ProtocolVersion]registryValue),
+	}
+}
+
+// Also compiles the type's codec, so that invalid tags fail at startup rather
+// than on the first packet.
+func (r *registry) register(t reflect.Type, protocol constants.ProtocolVersion, state constants.ClientState, id int) {
+	if err := data.Compile(t); err != nil {
+		panic(fmt.Sprintf("registering %v: %v", t, err))
+	}
+	r.byID[registryKey{Protocol: protocol, State: state, Id: id}] = t
+	if r.byType[t] == nil {
+		r.byType[t] = make(map[constants.ProtocolVersion]registryValue)
+	}
+	r.byType[t][protocol] = registryValue{State: state, Id: id}
+}
 
-func RegisterServerbound[T any](state constants.ClientState, id int) {
+var serverboundRegistry = newRegistry()
+
+var clientboundRegistry = newRegistry()
+
+func RegisterServerbound[T any](protocol constants.ProtocolVersion, state constants.ClientState, id int) {
 	t := reflect.TypeFor[T]()
 	sbField, sbExists := t.FieldByName("Serverbound")
 	if !sbExists || !sbField.Anonymous {
 		panic(fmt.Sprint("registering non-serverbound or non-anonymously-typed serverbound type ", t))
 	}
-	serverboundRegistry[serverboundRegistryKey{State: state, Id: id}] = t
+	serverboundRegistry.register(t, protocol, state, id)
 }
 
-func LookupServerbound(state constants.ClientState, id int) (reflect.Type, bool) {
-	t, found := serverboundRegistry[serverboundRegistryKey{State: state, Id: id}]
+func LookupServerbound(protocol constants.ProtocolVersion, state constants.ClientState, id int) (reflect.Type, bool) {
+	t, found := serverboundRegistry.byID[registryKey{Protocol: protocol, State: state, Id: id}]
 	return t, found
 }
 
-func DecodeServerbound(state constants.ClientState, packet *network.Packet) (ServerboundInterface, error) {
-	// TODO: move to buffer like with WriteAny...
-	// TODO: handle slice types
-	t, found := LookupServerbound(state, packet.Id)
-	if !found {
-		return nil, fmt.Errorf("could not find handler for packet in state %v with ID 0x%02x (%d) -- did you forget to call RegisterServerbound?", state, packet.Id, packet.Id)
-	}
+var ErrUnknownPacket = errors.New("unknown packet")
 
-	log.Printf("Decoding %v...\n", t)
+// Returned by the Decode functions for packets that are unknown or malformed.
+// Err wraps the data package's errors, e.g. data.ErrTrailingBytes.
+type DecodeError struct {
+	Protocol constants.ProtocolVersion
+	State    constants.ClientState
+	Id       int
+	Err      error
+}
 
-	msg := reflect.New(t)
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("decoding packet 0x%02X in protocol %d state %v: %v", e.Id, e.Protocol, e.State, e.Err)
+}
 
-	buf := data.NewBufferFromBytes(packet.Body)
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
 
-	for i := range t.NumField() {
-		f := t.Field(i)
-		if f.Anonymous {
-			continue
-		}
-
-		target := msg.Elem().Field(i)
-
-		if f.Type.Kind() == reflect.Slice {
-			tag, ok := f.Tag.Lookup("message")
-			if !ok {
-				return nil, fmt.Errorf("packet %v field %v is a slice type but is missing a `message:\"length...\" tag", t, f)
-			}
-			const lengthPrefix string = "length:"
-			suffix, ok := strings.CutPrefix(tag, lengthPrefix)
-			if ok {
-				value, err := buf.ReadReflectedSlice(f.Type.Elem(), data.BufferSliceLength(suffix))
-				if err != nil {
-					return nil, err
-				}
-				target.Set(value)
-				continue
-			} else {
-				return nil, fmt.Errorf("packet %v field %v tag has unknown contents", t, f)
-			}
-		} else {
-			value, err := buf.ReadReflected(f.Type)
-			if err != nil {
-				return nil, err
-			}
-			target.Set(value)
-		}
+// Decodes a serverbound packet into a pointer to its registered message type.
+// Handling is left to the caller, see serverbound.Handler.
+//
+// Decoding is strict, since the data comes from clients: lengths are limited
+// (see data.Buffer.Strict) and the whole body has to be consumed.
+func DecodeServerbound(protocol constants.ProtocolVersion, state constants.ClientState, packet *network.Packet) (any, error) {
+	t, found := LookupServerbound(protocol, state, packet.Id)
+	if !found {
+		return nil, &DecodeError{Protocol: protocol, State: state, Id: packet.Id, Err: ErrUnknownPacket}
 	}
 
-	return msg.Interface().(ServerboundInterface), nil
-}
-
-type clientboundRegistryValue struct {
-	State constants.ClientState
-	Id    int
+	msg, err := decode(t, protocol, packet, true)
+	if err != nil {
+		return nil, &DecodeError{Protocol: protocol, State: state, Id: packet.Id, Err: err}
+	}
+	return msg, nil
 }
 
-var clientboundRegistry = make(map[reflect.Type]clientboundRegistryValue)
-
-func RegisterClientbound[T any](state constants.ClientState, id int) {
+func RegisterClientbound[T any](protocol constants.ProtocolVersion, state constants.ClientState, id int) {
 	t := reflect.TypeFor[T]()
 	sbField, sbExists := t.FieldByName("Clientbound")
 	if !sbExists || !sbField.Anonymous {
 		panic(fmt.Sprint("registering non-clientbound or non-anonymously-typed clientbound type ", t))
 	}
-	clientboundRegistry[t] = clientboundRegistryValue{State: state, Id: id}
+	clientboundRegistry.register(t, protocol, state, id)
 }
 
-func LookupClientbound(t reflect.Type) (clientboundRegistryValue, bool) {
-	v, found := clientboundRegistry[t]
+func LookupClientbound(protocol constants.ProtocolVersion, t reflect.Type) (registryValue, bool) {
+	v, found := clientboundRegistry.byType[t][protocol]
 	return v, found
 }
 
+// Decodes a clientbound packet into a pointer to its registered message type.
+// Unlike DecodeServerbound this is lenient: servers are trusted, and may send
+// fields after the ones known here.
+func DecodeClientbound(protocol constants.ProtocolVersion, state constants.ClientState, packet *network.Packet) (any, error) {
+	t, found := clientboundRegistry.byID[registryKey{Protocol: protocol, State: state, Id: packet.Id}]
+	if !found {
+		return nil, &DecodeError{Protocol: protocol, State: state, Id: packet.Id, Err: ErrUnknownPacket}
+	}
+	msg, err := decode(t, protocol, packet, false)
+	if err != nil {
+		return nil, &DecodeError{Protocol: protocol, State: state, Id: packet.Id, Err: err}
+	}
+	return msg, nil
+}
+
+func decode(t reflect.Type, protocol constants.ProtocolVersion, packet *network.Packet, strict bool) (any, error) {
+	msg := reflect.New(t)
+
+	buf := data.NewBufferFromBytes(packet.Body)
+	buf.Protocol = protocol
+	buf.Strict = strict
+	if err := buf.ReadFields(msg.Elem()); err != nil {
+		return nil, err
+	}
+	if err := buf.CheckConsumed(); err != nil {
+		return nil, fmt.Errorf("%v: %w", t, err)
+	}
+
+	return msg.Interface(), nil
+}
+
+// Encodes a message for the latest protocol version. The message is kept in the
+// packet so that it can be encoded again for clients on other versions, see
+// EncodeFor.
 func Encode[T any](msg *T) (network.Packet, error) {
+	return EncodeFor(constants.ProtocolLatest, msg)
+}
+
+// Encodes a message (a pointer to a registered clientbound type) for the given
+// protocol version.
+func EncodeFor(protocol constants.ProtocolVersion, msg any) (network.Packet, error) {
+	return encode(clientboundRegistry, "RegisterClientbound", protocol, msg)
+}
+
+// Encodes a message (a pointer to a registered serverbound type) for the given
+// protocol version, for use by clients.
+func EncodeServerbound(protocol constants.ProtocolVersion, msg any) (network.Packet, error) {
+	return encode(serverboundRegistry, "RegisterServerbound", protocol, msg)
+}
+
+func encode(r *registry, registerFunc string, protocol constants.ProtocolVersion, msg any) (network.Packet, error) {
 	t := reflect.TypeOf(msg).Elem()
-	info, found := LookupClientbound(t)
+	ids, found := r.byType[t]
+	if !found {
+		return network.Packet{}, fmt.Errorf("could not find ID for message %v -- did you forget to call %s?", t, registerFunc)
+	}
+	info, found := ids[protocol]
 	if !found {
-		return network.Packet{}, fmt.Errorf("could not find ID for message %v -- did you forget to call RegisterClientbound?", t)
+		return network.Packet{}, fmt.Errorf("message %v does not exist in protocol %d", t, protocol)
 	}
 
-	var wbuf data.Buffer
-
-	for i := range t.NumField() {
-		f := t.Field(i)
-		if f.Anonymous {
-			continue
-		}
-
-		value := reflect.ValueOf(msg).Elem().Field(i).Interface()
-
-		// First we have to handle slice types
-		if f.Type.Kind() == reflect.Slice {
-			// For slices, a tag must be set: `message:"length,<ltype>"`
-			// where <ltype> is one of { varint }
-			tag, ok := f.Tag.Lookup("message")
-			if !ok {
-				return network.Packet{}, fmt.Errorf("packet %v field %v is a slice type but is missing a `message:\"length...\" tag", t, f)
-			}
-			const lengthPrefix string = "length:"
-			suffix, ok := strings.CutPrefix(tag, lengthPrefix)
-			if ok {
-				err := wbuf.WriteSlice(value, data.BufferSliceLength(suffix))
-				if err != nil {
-					return network.Packet{}, err
-				}
-			} else {
-				return network.Packet{}, fmt.Errorf("packet %v field %v tag has unknown contents", t, f)
-			}
-		} else {
-			err := wbuf.WriteAny(value)
-			if err != nil {
-				return network.Packet{}, err
-			}
-		}
+	wbuf := data.Buffer{Protocol: protocol}
+	if err := wbuf.WriteFields(reflect.ValueOf(msg).Elem()); err != nil {
+		return network.Packet{}, fmt.Errorf("packet %v: %w", t, err)
 	}
 
-	return network.Packet{Id: info.Id, Body: wbuf.Raw}, nil
+	return network.Packet{Id: info.Id, Body: wbuf.Raw, Message: msg, Protocol: protocol}, nil
 }
@@ -0,0 +1,16 @@
+package clientbound
+
+// Bits of PlayClientboundPlayerInfoUpdate.Actions, which decide the fields of
+// each player. The generated fields are those of PlayerInfoAdd, the only
+// combination the server sends.
+const (
+	PlayerInfoAddPlayer      int8 = 0x01
+	PlayerInfoInitializeChat int8 = 0x02
+	PlayerInfoUpdateGameMode int8 = 0x04
+	PlayerInfoUpdateListed   int8 = 0x08
+	PlayerInfoUpdateLatency  int8 = 0x10
+	PlayerInfoUpdateName     int8 = 0x20
+
+	// Adds players to the player list, with their game mode and latency
+	PlayerInfoAdd = PlayerInfoAddPlayer | PlayerInfoUpdateGameMode | PlayerInfoUpdateListed | PlayerInfoUpdateLatency
+)
@@ -0,0 +1,11 @@
+package clientbound
+
+// Bits of PlayClientboundSynchronizePlayerPosition.Flags. When a bit is set the
+// corresponding field is relative to the current value instead of absolute.
+const (
+	SyncPositionRelativeX     byte = 0x01
+	SyncPositionRelativeY     byte = 0x02
+	SyncPositionRelativeZ     byte = 0x04
+	SyncPositionRelativeYaw   byte = 0x08
+	SyncPositionRelativePitch byte = 0x10
+)
@@ -0,0 +1,121 @@
+package messages_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/network/messages/serverbound"
+)
+
+// Sent by every moving player every tick.
+func movement() *serverbound.PlayServerboundSetPlayerPosition {
+	return &serverbound.PlayServerboundSetPlayerPosition{X: 12.5, FeetY: 64, Z: -1031.25, OnGround: true}
+}
+
+// A chunk the size of a full overworld column: 24 sections with 4-bit block
+// palettes, heightmaps and sky light for every section.
+func chunk() *clientbound.PlayClientboundChunkDataAndUpdateLight {
+	rng := rand.New(rand.NewPCG(1, 2))
+	random := func(n int) []byte {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = byte(rng.Uint32())
+		}
+		return b
+	}
+
+	var sections data.Buffer
+	for range 24 {
+		sections.WriteShort(4096)
+		sections.WriteUByte(4)
+		sections.WriteVarInt(16)
+		for id := range 16 {
+			sections.WriteVarInt(data.VarInt(id))
+		}
+		sections.WriteVarInt(256)
+		sections.Write(random(256 * 8))
+		// Single valued biomes
+		sections.WriteUByte(0)
+		sections.WriteVarInt(0)
+		sections.WriteVarInt(0)
+	}
+
+	heightmap := make([]int64, 37)
+	for i := range heightmap {
+		heightmap[i] = int64(rng.Uint64())
+	}
+	m := &clientbound.PlayClientboundChunkDataAndUpdateLight{
+		ChunkX: 3,
+		ChunkZ: -7,
+		Heightmaps: data.NBTCompoundValue(nil, []*data.NBTValue{
+			data.NBTLongArrayValue("MOTION_BLOCKING", heightmap),
+			data.NBTLongArrayValue("WORLD_SURFACE", heightmap),
+		}),
+		Data:                sections.Raw,
+		SkyLightMask:        []int64{1<<26 - 1},
+		BlockLightMask:      []int64{0},
+		EmptySkyLightMask:   []int64{0},
+		EmptyBlockLightMask: []int64{1<<26 - 1},
+	}
+	for range 26 {
+		m.SkyLightArrays = append(m.SkyLightArrays, clientbound.PlayClientboundChunkDataAndUpdateLight_SkyLightArray{Data: random(2048)})
+	}
+	return m
+}
+
+func BenchmarkEncodeMovement(b *testing.B) {
+	msg := movement()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, err := messages.EncodeServerbound(constants.ProtocolLatest, msg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeServerboundMovement(b *testing.B) {
+	packet, err := messages.EncodeServerbound(constants.ProtocolLatest, movement())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, err := messages.DecodeServerbound(constants.ProtocolLatest, constants.ClientStatePlay, &packet); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncodeChunk(b *testing.B) {
+	msg := chunk()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		packet, err := messages.Encode(msg)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.SetBytes(int64(len(packet.Body)))
+	}
+}
+
+func BenchmarkDecodeClientboundChunk(b *testing.B) {
+	packet, err := messages.Encode(chunk())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(packet.Body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, err := messages.DecodeClientbound(constants.ProtocolLatest, constants.ClientStatePlay, &packet); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package messages_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/network/messages/serverbound"
+)
+
+func chatMessage(message string) *serverbound.PlayServerboundChatMessage {
+	return &serverbound.PlayServerboundChatMessage{Message: message, Timestamp: 1718274030000, Salt: 42}
+}
+
+func TestDecodeServerboundStrict(t *testing.T) {
+	tests := []struct {
+		name string
+		// Changes the encoded packet
+		edit  func(p *network.Packet)
+		msg   *serverbound.PlayServerboundChatMessage
+		err   error
+		field string
+	}{
+		{"Valid", func(p *network.Packet) {}, chatMessage("hello"), nil, ""},
+		{"AtMaxLength", func(p *network.Packet) {}, chatMessage(strings.Repeat("a", 256)), nil, ""},
+		{"TooLong", func(p *network.Packet) {}, chatMessage(strings.Repeat("a", 257)), data.ErrStringTooLong, "Message"},
+		{"TrailingBytes", func(p *network.Packet) { p.Body = append(p.Body, 0, 0) }, chatMessage("hello"), data.ErrTrailingBytes, ""},
+		{"Truncated", func(p *network.Packet) { p.Body = p.Body[:len(p.Body)-1] }, chatMessage("hello"), data.ErrBufferTooShort, "Acknowledged"},
+		{"UnknownID", func(p *network.Packet) { p.Id = 0x7F }, chatMessage("hello"), messages.ErrUnknownPacket, ""},
+	}
+	for _, protocol := range constants.SupportedProtocols {
+		for _, tt := range tests {
+			t.Run(protocol.String()+"/"+tt.name, func(t *testing.T) {
+				packet, err := messages.EncodeServerbound(protocol, tt.msg)
+				if err != nil {
+					t.Fatal(err)
+				}
+				tt.edit(&packet)
+
+				msg, err := messages.DecodeServerbound(protocol, constants.ClientStatePlay, &packet)
+				if tt.err == nil {
+					if err != nil {
+						t.Fatal(err)
+					}
+					if got := msg.(*serverbound.PlayServerboundChatMessage); *got != *tt.msg {
+						t.Errorf("got %+v, want %+v", got, tt.msg)
+					}
+					return
+				}
+
+				// Everything is wrapped in a DecodeError, which is what the
+				// client is disconnected for
+				var decodeErr *messages.DecodeError
+				if !errors.As(err, &decodeErr) {
+					t.Fatalf("got %T %v, want a *messages.DecodeError", err, err)
+				}
+				if decodeErr.Protocol != protocol || decodeErr.State != constants.ClientStatePlay || decodeErr.Id != packet.Id {
+					t.Errorf("got error for %d %v 0x%02X, want %d play 0x%02X", decodeErr.Protocol, decodeErr.State, decodeErr.Id, protocol, packet.Id)
+				}
+				if !errors.Is(err, tt.err) {
+					t.Errorf("got %v, want it to wrap %v", err, tt.err)
+				}
+				var fieldErr *data.FieldError
+				if errors.As(err, &fieldErr) != (tt.field != "") {
+					t.Fatalf("got %v, want a field error %v", err, tt.field != "")
+				}
+				if fieldErr != nil && fieldErr.Field != tt.field {
+					t.Errorf("got the error for field %s, want %s", fieldErr.Field, tt.field)
+				}
+			})
+		}
+	}
+}
+
+func TestDecodeServerboundOptional(t *testing.T) {
+	var signature [256]byte
+	signature[0], signature[255] = 1, 2
+	want := chatMessage("signed")
+	want.Signature = &signature
+	want.Offset = 3
+	want.Acknowledged = [3]byte{4, 5, 6}
+
+	packet, err := messages.EncodeServerbound(constants.ProtocolLatest, want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := messages.DecodeServerbound(constants.ProtocolLatest, constants.ClientStatePlay, &packet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := msg.(*serverbound.PlayServerboundChatMessage)
+	if got.Signature == nil || *got.Signature != signature || got.Offset != 3 || got.Acknowledged != want.Acknowledged {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	// Without the signature the boolean says so, and nothing follows it
+	packet.Body = packet.Body[:len(packet.Body)-len(signature)-1-1-3]
+	packet.Body = append(packet.Body, 0, 3, 4, 5, 6)
+	msg, err = messages.DecodeServerbound(constants.ProtocolLatest, constants.ClientStatePlay, &packet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := msg.(*serverbound.PlayServerboundChatMessage); got.Signature != nil {
+		t.Errorf("got a signature %v, want none", got.Signature)
+	}
+}
+
+// Servers are trusted, so clientbound packets are decoded without the limits.
+func TestDecodeClientboundLenient(t *testing.T) {
+	content := strings.Repeat("a", data.DefaultMaxStringLength+1)
+	packet, err := messages.Encode(&clientbound.PlayClientboundSystemChatMessage{
+		Content: data.MakeChat().SetText(content).ToNBT(nil),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	packet.Body = append(packet.Body, 1, 2, 3)
+	msg, err := messages.DecodeClientbound(constants.ProtocolLatest, constants.ClientStatePlay, &packet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text := msg.(*clientbound.PlayClientboundSystemChatMessage).Content.Get("text"); text == nil || text.Value != content {
+		t.Errorf("got content %v", text)
+	}
+
+	packet.Id = 0x7F
+	var decodeErr *messages.DecodeError
+	if _, err := messages.DecodeClientbound(constants.ProtocolLatest, constants.ClientStatePlay, &packet); !errors.As(err, &decodeErr) || !errors.Is(err, messages.ErrUnknownPacket) {
+		t.Errorf("got %v for an unknown packet, want a DecodeError wrapping ErrUnknownPacket", err)
+	}
+}
+
+// Every serverbound ID the client uses has to be registered, or the packet is
+// dropped.
+func TestServerboundIDsComplete(t *testing.T) {
+	tests := []struct {
+		protocol constants.ProtocolVersion
+		state    constants.ClientState
+		count    int
+	}{
+		{constants.Protocol1_20_2, constants.ClientStateConfiguration, 0x06},
+		{constants.Protocol1_20_2, constants.ClientStatePlay, 0x36},
+		{constants.Protocol1_20_3, constants.ClientStateConfiguration, 0x06},
+		{constants.Protocol1_20_3, constants.ClientStatePlay, 0x37},
+		{constants.Protocol1_20_5, constants.ClientStateConfiguration, 0x08},
+		{constants.Protocol1_20_5, constants.ClientStatePlay, 0x3A},
+		{constants.Protocol1_21, constants.ClientStateConfiguration, 0x08},
+		{constants.Protocol1_21, constants.ClientStatePlay, 0x3A},
+	}
+	for _, tt := range tests {
+		for id := range tt.count + 1 {
+			_, ok := messages.LookupServerbound(tt.protocol, tt.state, id)
+			if ok != (id < tt.count) {
+				t.Errorf("%d %v 0x%02X: registered %v, want %v", tt.protocol, tt.state, id, ok, id < tt.count)
+			}
+		}
+	}
+}
+
+// Packets without a known layout keep their body as is.
+func TestDecodeServerboundRest(t *testing.T) {
+	want := &serverbound.PlayServerboundPluginMessage{Channel: "minecraft:brand", Data: []byte("\x07vanilla")}
+	packet, err := messages.EncodeServerbound(constants.ProtocolLatest, want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := messages.DecodeServerbound(constants.ProtocolLatest, constants.ClientStatePlay, &packet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := msg.(*serverbound.PlayServerboundPluginMessage); got.Channel != want.Channel || string(got.Data) != string(want.Data) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
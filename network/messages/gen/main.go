@@ -0,0 +1,737 @@
+// Generates the serverbound and clientbound message structs and their
+// registrations from a protocol description in the format used by
+// PrismarineJS/minecraft-data (protocol.json).
+//
+// Every state has a toClient and toServer section whose "packet" type maps
+// packet IDs to names, and a "packet_<name>" container per packet:
+//
+//	"packet_block_update": ["container", [
+//		{"name": "location", "type": "position"},
+//		{"name": "blockId", "type": "varint"}
+//	]]
+//
+// becomes PlayClientboundBlockUpdate{Location data.Position; BlockID data.VarInt},
+// registered in the play state with its ID. Behaviour (Handle methods, custom
+// BufferWrite implementations) stays in the hand-written files next to the
+// generated ones.
+//
+// Each protocol version has its own directory with a protocol.json and a
+// version.json ({"version": 767, "minecraftVersion": "1.21"}). A packet is
+// generated once for all versions: fields missing from some versions get
+// `since`/`until` tags, and the packet is registered with its ID in every
+// version it exists in.
+//
+// Optional fields (["option", type]) become pointers, fixed-size buffers and
+// arrays ({"count": 256}) Go arrays, and a switch with a single case on an
+// earlier field becomes a field with an `if` tag. Enums (mapper) are generated
+// as their underlying type.
+//
+// Beyond minecraft-data's format, pstring, buffer and array options can have a
+// "maxLength", enforced when decoding serverbound packets:
+//
+//	{"name": "username", "type": ["pstring", {"countType": "varint", "maxLength": 16}]}
+//
+// Every packet of the version is listed, so none is unknown to the server.
+// Packets whose fields aren't needed have their body as a single restBuffer
+// field, which is kept as is:
+//
+//	"packet_commands": ["container", [{"name": "data", "type": "restBuffer"}]]
+//
+// Run `go generate ./network/messages` after editing protocol.json.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	importConstants = "github.com/brenfwd/gocraft/constants"
+	importData      = "github.com/brenfwd/gocraft/data"
+	importMessages  = "github.com/brenfwd/gocraft/network/messages"
+	importUUID      = "github.com/google/uuid"
+)
+
+type native struct {
+	goType string
+	pkg    string // import path needed by goType, if any
+}
+
+// Types with a fixed Go representation. Anything else has to be built from
+// these with pstring, buffer, array, container, option, switch and mapper.
+var natives = map[string]native{
+	"varint":            {"data.VarInt", importData},
+	"varlong":           {"data.VarLong", importData},
+	"bool":              {"bool", ""},
+	"i8":                {"int8", ""},
+	"u8":                {"byte", ""},
+	"i16":               {"int16", ""},
+	"u16":               {"uint16", ""},
+	"i32":               {"int32", ""},
+	"i64":               {"int64", ""},
+	"f32":               {"float32", ""},
+	"f64":               {"float64", ""},
+	"UUID":              {"uuid.UUID", importUUID},
+	"position":          {"data.Position", importData},
+	"angle":             {"data.Angle", importData},
+	"anonymousNbt":      {"*data.NBTValue", importData},
+	"entityMetadata":    {"*data.EntityMetadata", importData},
+	"jsonTextComponent": {"data.Chat", importData},
+}
+
+// States in protocol.json, in the order they are generated.
+var states = []struct {
+	key      string
+	constant string
+}{
+	{"handshaking", "ClientStateHandshaking"},
+	{"status", "ClientStateStatus"},
+	{"login", "ClientStateLogin"},
+	{"configuration", "ClientStateConfiguration"},
+	{"play", "ClientStatePlay"},
+}
+
+var directions = []struct {
+	key   string
+	pkg   string // package and directory name
+	embed string // marker type embedded in every message
+	kind  string // part of the type name and Register function
+}{
+	{"toServer", "serverbound", "messages.Serverbound", "Serverbound"},
+	{"toClient", "clientbound", "messages.Clientbound", "Clientbound"},
+}
+
+type section struct {
+	Types map[string]json.RawMessage `json:"types"`
+}
+
+type protocol struct {
+	// Top-level type aliases, e.g. "string": ["pstring", {"countType": "varint"}]
+	types  map[string]json.RawMessage
+	states map[string]map[string]section
+}
+
+func readProtocol(path string) (*protocol, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &top); err != nil {
+		return nil, err
+	}
+	p := &protocol{types: map[string]json.RawMessage{}, states: map[string]map[string]section{}}
+	for key, value := range top {
+		if key == "types" {
+			if err := json.Unmarshal(value, &p.types); err != nil {
+				return nil, fmt.Errorf("types: %w", err)
+			}
+			continue
+		}
+		var sections map[string]section
+		if err := json.Unmarshal(value, &sections); err != nil {
+			return nil, fmt.Errorf("state %s: %w", key, err)
+		}
+		p.states[key] = sections
+	}
+	return p, nil
+}
+
+type packet struct {
+	id   int
+	name string
+}
+
+// Reads the ID -> name mappings out of the "packet" container:
+//
+//	["container", [
+//		{"name": "name", "type": ["mapper", {"type": "varint", "mappings": {"0x00": "handshake"}}]},
+//		{"name": "params", "type": ["switch", {"compareTo": "name", "fields": {"handshake": "packet_handshake"}}]}
+//	]]
+func readPackets(s section) ([]packet, error) {
+	raw, ok := s.Types["packet"]
+	if !ok {
+		return nil, nil
+	}
+	var container []json.RawMessage
+	if err := json.Unmarshal(raw, &container); err != nil || len(container) != 2 {
+		return nil, fmt.Errorf("packet type is not a container")
+	}
+	var fields []struct {
+		Name string            `json:"name"`
+		Type []json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(container[1], &fields); err != nil {
+		return nil, fmt.Errorf("packet container: %w", err)
+	}
+
+	var packets []packet
+	for _, f := range fields {
+		if f.Name != "name" || len(f.Type) != 2 {
+			continue
+		}
+		var mapper struct {
+			Mappings map[string]string `json:"mappings"`
+		}
+		if err := json.Unmarshal(f.Type[1], &mapper); err != nil {
+			return nil, fmt.Errorf("packet name mapper: %w", err)
+		}
+		for hexID, name := range mapper.Mappings {
+			id, err := strconv.ParseInt(hexID, 0, 32)
+			if err != nil {
+				return nil, fmt.Errorf("packet %s has invalid ID %q", name, hexID)
+			}
+			packets = append(packets, packet{id: int(id), name: name})
+		}
+	}
+	sort.Slice(packets, func(i, j int) bool { return packets[i].id < packets[j].id })
+	return packets, nil
+}
+
+// handshake -> Handshake, set_player_position -> SetPlayerPosition
+func pascalSnake(name string) string {
+	var sb strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		sb.WriteString(initialism(part))
+	}
+	return sb.String()
+}
+
+// entityId -> EntityID, playerUuid -> PlayerUUID
+func pascalCamel(name string) string {
+	var sb strings.Builder
+	start := 0
+	for i := 1; i <= len(name); i++ {
+		if i == len(name) || (name[i] >= 'A' && name[i] <= 'Z') {
+			sb.WriteString(initialism(name[start:i]))
+			start = i
+		}
+	}
+	return sb.String()
+}
+
+func initialism(word string) string {
+	switch strings.ToLower(word) {
+	case "":
+		return ""
+	case "id":
+		return "ID"
+	case "ids":
+		return "IDs"
+	case "uuid":
+		return "UUID"
+	case "json":
+		return "JSON"
+	}
+	return strings.ToUpper(word[:1]) + word[1:]
+}
+
+// KnownPacks -> KnownPack, Properties -> Property
+func singular(name string) string {
+	if s, ok := strings.CutSuffix(name, "ies"); ok {
+		return s + "y"
+	}
+	if s, ok := strings.CutSuffix(name, "s"); ok {
+		return s
+	}
+	return name + "Entry"
+}
+
+type file struct {
+	imports map[string]bool
+	inits   bytes.Buffer
+	decls   bytes.Buffer
+	// Nested structs written so far, to catch containers with the same name
+	// but different fields.
+	nested map[string]string
+}
+
+type containerField struct {
+	Name string          `json:"name"`
+	Type json.RawMessage `json:"type"`
+}
+
+// A protocol version, read from protocol/<version>/{version,protocol}.json.
+type version struct {
+	protocol         int
+	minecraftVersion string
+	proto            *protocol
+}
+
+// Name of the constant in the constants package, e.g. Protocol1_21.
+func (v *version) constant() string {
+	return "Protocol" + strings.ReplaceAll(v.minecraftVersion, ".", "_")
+}
+
+func readVersion(dir string) (*version, error) {
+	raw, err := os.ReadFile(filepath.Join(dir, "version.json"))
+	if err != nil {
+		return nil, err
+	}
+	var info struct {
+		Version          int    `json:"version"`
+		MinecraftVersion string `json:"minecraftVersion"`
+	}
+	if err := json.Unmarshal(raw, &info); err != nil {
+		return nil, fmt.Errorf("%s: %w", dir, err)
+	}
+	proto, err := readProtocol(filepath.Join(dir, "protocol.json"))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", dir, err)
+	}
+	return &version{protocol: info.Version, minecraftVersion: info.MinecraftVersion, proto: proto}, nil
+}
+
+// A struct field, resolved for one protocol version.
+type field struct {
+	name   string
+	goType string
+	tag    string
+}
+
+type generator struct {
+	versions []*version // oldest first
+}
+
+// Returns the Go type for a protocol type, and the `message` tag its field needs.
+// Nested containers are emitted as structs named after owner and field.
+func (g *generator) goType(f *file, v *version, raw json.RawMessage, owner, fieldName string) (string, string, error) {
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		// Everything up to the end of the packet
+		if name == "restBuffer" {
+			return "[]byte", "rest", nil
+		}
+		if n, ok := natives[name]; ok {
+			if n.pkg != "" {
+				f.imports[n.pkg] = true
+			}
+			return n.goType, "", nil
+		}
+		alias, ok := v.proto.types[name]
+		if !ok || string(alias) == `"native"` {
+			return "", "", fmt.Errorf("unsupported type %q", name)
+		}
+		return g.goType(f, v, alias, owner, fieldName)
+	}
+
+	var compound []json.RawMessage
+	if err := json.Unmarshal(raw, &compound); err != nil || len(compound) != 2 {
+		return "", "", fmt.Errorf("invalid type %s", raw)
+	}
+	if err := json.Unmarshal(compound[0], &name); err != nil {
+		return "", "", fmt.Errorf("invalid type %s", raw)
+	}
+	var opts struct {
+		CountType string          `json:"countType"`
+		Count     int             `json:"count"`
+		Type      json.RawMessage `json:"type"`
+		MaxLength int             `json:"maxLength"`
+	}
+	length := ""
+	switch name {
+	case "pstring", "buffer", "array":
+		if err := json.Unmarshal(compound[1], &opts); err != nil {
+			return "", "", fmt.Errorf("%s options: %w", name, err)
+		}
+		var ok bool
+		if length, ok = lengthTypes[opts.CountType]; !ok && (opts.Count == 0 || name == "pstring") {
+			return "", "", fmt.Errorf("%s with count type %q is not supported", name, opts.CountType)
+		}
+	}
+
+	maxLen := ""
+	if opts.MaxLength > 0 {
+		maxLen = fmt.Sprintf(",maxlen:%d", opts.MaxLength)
+	}
+
+	switch name {
+	case "pstring":
+		if length != "varint" {
+			return "", "", fmt.Errorf("pstring with count type %q is not supported", opts.CountType)
+		}
+		return "string", strings.TrimPrefix(maxLen, ","), nil
+	case "buffer":
+		if opts.Count > 0 {
+			return fmt.Sprintf("[%d]byte", opts.Count), "", nil
+		}
+		return "[]byte", "length:" + length + maxLen, nil
+	case "array":
+		elem, tag, err := g.goType(f, v, opts.Type, owner, singular(fieldName))
+		if err != nil {
+			return "", "", err
+		}
+		if tag != "" {
+			return "", "", fmt.Errorf("array elements can't have options (nested arrays, maxLength)")
+		}
+		if opts.Count > 0 {
+			return fmt.Sprintf("[%d]%s", opts.Count, elem), "", nil
+		}
+		return "[]" + elem, "length:" + length + maxLen, nil
+	case "option":
+		elem, tag, err := g.goType(f, v, compound[1], owner, fieldName)
+		if err != nil {
+			return "", "", err
+		}
+		if !strings.HasPrefix(elem, "*") {
+			elem = "*" + elem
+		}
+		return elem, joinTag("optional", tag), nil
+	case "mapper":
+		// Enums, generated as their underlying type
+		var mapper struct {
+			Type json.RawMessage `json:"type"`
+		}
+		if err := json.Unmarshal(compound[1], &mapper); err != nil {
+			return "", "", fmt.Errorf("mapper options: %w", err)
+		}
+		return g.goType(f, v, mapper.Type, owner, fieldName)
+	case "switch":
+		// Only a single case on an earlier field of the same container is
+		// supported, with the field absent otherwise
+		var sw struct {
+			CompareTo string                     `json:"compareTo"`
+			Fields    map[string]json.RawMessage `json:"fields"`
+			Default   string                     `json:"default"`
+		}
+		if err := json.Unmarshal(compound[1], &sw); err != nil {
+			return "", "", fmt.Errorf("switch options: %w", err)
+		}
+		if len(sw.Fields) != 1 || sw.Default != "void" || strings.Contains(sw.CompareTo, "/") {
+			return "", "", fmt.Errorf("switch on %q is not supported", sw.CompareTo)
+		}
+		for value, typ := range sw.Fields {
+			elem, tag, err := g.goType(f, v, typ, owner, fieldName)
+			if err != nil {
+				return "", "", err
+			}
+			cond := "if:" + pascalCamel(sw.CompareTo)
+			if value != "true" {
+				if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+					return "", "", fmt.Errorf("switch case %q is not supported", value)
+				}
+				cond += "=" + value
+			}
+			return elem, joinTag(cond, tag), nil
+		}
+		panic("unreachable")
+	case "container":
+		typeName := owner + "_" + fieldName
+		fields, err := g.fields(f, v, typeName, compound[1])
+		if err != nil {
+			return "", "", err
+		}
+		var body bytes.Buffer
+		writeStruct(&body, typeName, "", fields)
+		if prev, ok := f.nested[typeName]; ok && prev != body.String() {
+			return "", "", fmt.Errorf("%s differs between protocol versions", typeName)
+		} else if !ok {
+			f.nested[typeName] = body.String()
+			f.decls.Write(body.Bytes())
+		}
+		return typeName, "", nil
+	default:
+		return "", "", fmt.Errorf("unsupported type %q", name)
+	}
+}
+
+// Length prefixes of pstring, buffer and array, as `message` tag length types.
+var lengthTypes = map[string]string{
+	"varint": "varint",
+	"u8":     "byte",
+	"i16":    "short",
+	"i32":    "int",
+}
+
+func joinTag(a, b string) string {
+	if b == "" {
+		return a
+	}
+	return a + "," + b
+}
+
+func (g *generator) fields(f *file, v *version, typeName string, raw json.RawMessage) ([]field, error) {
+	var container []containerField
+	if err := json.Unmarshal(raw, &container); err != nil {
+		return nil, fmt.Errorf("%s: %w", typeName, err)
+	}
+	fields := make([]field, len(container))
+	for i, c := range container {
+		name := pascalCamel(c.Name)
+		typ, tag, err := g.goType(f, v, c.Type, typeName, name)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", typeName, name, err)
+		}
+		fields[i] = field{name: name, goType: typ, tag: tag}
+	}
+	return fields, nil
+}
+
+func writeStruct(out *bytes.Buffer, typeName, embed string, fields []field) {
+	fmt.Fprintf(out, "type %s struct {\n", typeName)
+	if embed != "" {
+		fmt.Fprintf(out, "\t%s\n", embed)
+	}
+	for _, f := range fields {
+		if f.tag != "" {
+			fmt.Fprintf(out, "\t%s %s `message:\"%s\"`\n", f.name, f.goType, f.tag)
+		} else {
+			fmt.Fprintf(out, "\t%s %s\n", f.name, f.goType)
+		}
+	}
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out)
+}
+
+// Merges the fields of a packet in every version it exists in. Fields are
+// matched by name; a field missing from some versions is tagged with the range
+// of versions it exists in, which has to be contiguous. Fields that change type
+// need a different name per version.
+func (g *generator) mergeFields(typeName string, perVersion [][]field) ([]field, error) {
+	type merged struct {
+		field
+		present []bool
+	}
+	var order []*merged
+	byName := map[string]*merged{}
+
+	// Start from the newest version and insert fields of older versions after
+	// the field preceding them.
+	for vi := len(perVersion) - 1; vi >= 0; vi-- {
+		insertAt := 0
+		for _, f := range perVersion[vi] {
+			m, ok := byName[f.name]
+			if !ok {
+				m = &merged{field: f, present: make([]bool, len(perVersion))}
+				byName[f.name] = m
+				order = slices.Insert(order, insertAt, m)
+			} else if m.goType != f.goType || m.tag != f.tag {
+				return nil, fmt.Errorf("%s.%s changes type between protocol versions", typeName, f.name)
+			}
+			m.present[vi] = true
+			insertAt = slices.Index(order, m) + 1
+		}
+	}
+
+	// The merged order has to agree with every version's order.
+	for vi, fields := range perVersion {
+		var names []string
+		for _, m := range order {
+			if m.present[vi] {
+				names = append(names, m.name)
+			}
+		}
+		for i, f := range fields {
+			if names[i] != f.name {
+				return nil, fmt.Errorf("%s: field order in %s conflicts with other versions", typeName, g.versions[vi].minecraftVersion)
+			}
+		}
+	}
+
+	result := make([]field, len(order))
+	for i, m := range order {
+		first := slices.Index(m.present, true)
+		last := first
+		for vi, present := range m.present {
+			if present {
+				last = vi
+			}
+		}
+		for vi := first; vi <= last; vi++ {
+			if !m.present[vi] {
+				return nil, fmt.Errorf("%s.%s is missing from %s but exists before and after it", typeName, m.name, g.versions[vi].minecraftVersion)
+			}
+		}
+		// Only versions the packet exists in need to be excluded.
+		exists := func(fields []field) bool { return fields != nil }
+		var opts []string
+		if m.tag != "" {
+			opts = append(opts, m.tag)
+		}
+		if slices.ContainsFunc(perVersion[:first], exists) {
+			opts = append(opts, fmt.Sprintf("since:%d", g.versions[first].protocol))
+		}
+		if slices.ContainsFunc(perVersion[last+1:], exists) {
+			opts = append(opts, fmt.Sprintf("until:%d", g.versions[last].protocol))
+		}
+		result[i] = field{name: m.name, goType: m.goType, tag: strings.Join(opts, ",")}
+	}
+	return result, nil
+}
+
+type packetKey struct {
+	state string
+	name  string
+}
+
+func (g *generator) generate(dir string, pkg, embed, kind string) ([]byte, error) {
+	f := &file{imports: map[string]bool{}, nested: map[string]string{}}
+	latest := len(g.versions) - 1
+
+	for _, state := range states {
+		// Packets of the state, ordered by their ID in the newest version they
+		// exist in.
+		var keys []packetKey
+		lastID := map[packetKey]int{}
+		lastVersion := map[packetKey]int{}
+		ids := map[packetKey][]int{}
+		for vi, v := range g.versions {
+			s, ok := v.proto.states[state.key][dir]
+			if !ok {
+				continue
+			}
+			packets, err := readPackets(s)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s.%s: %w", v.minecraftVersion, state.key, dir, err)
+			}
+			for _, p := range packets {
+				key := packetKey{state.key, p.name}
+				if _, ok := ids[key]; !ok {
+					keys = append(keys, key)
+					ids[key] = make([]int, len(g.versions))
+					for i := range ids[key] {
+						ids[key][i] = -1
+					}
+				}
+				ids[key][vi] = p.id
+				lastID[key] = p.id
+				lastVersion[key] = vi
+			}
+		}
+		sort.SliceStable(keys, func(i, j int) bool {
+			if lastVersion[keys[i]] != lastVersion[keys[j]] {
+				return lastVersion[keys[i]] > lastVersion[keys[j]]
+			}
+			return lastID[keys[i]] < lastID[keys[j]]
+		})
+
+		for _, key := range keys {
+			typeName := pascalSnake(state.key) + kind + pascalSnake(key.name)
+			perVersion := make([][]field, len(g.versions))
+			for vi, v := range g.versions {
+				if ids[key][vi] < 0 {
+					continue
+				}
+				s := v.proto.states[state.key][dir]
+				raw, ok := s.Types["packet_"+key.name]
+				if !ok {
+					return nil, fmt.Errorf("%s %s.%s: packet %s has no packet_%s type", v.minecraftVersion, state.key, dir, key.name, key.name)
+				}
+				var container []json.RawMessage
+				if err := json.Unmarshal(raw, &container); err != nil || len(container) != 2 {
+					return nil, fmt.Errorf("%s %s.%s: packet_%s is not a container", v.minecraftVersion, state.key, dir, key.name)
+				}
+				fields, err := g.fields(f, v, typeName, container[1])
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", v.minecraftVersion, err)
+				}
+				// Non-nil even without fields, nil means the packet doesn't
+				// exist in the version.
+				perVersion[vi] = fields
+			}
+			fields, err := g.mergeFields(typeName, perVersion)
+			if err != nil {
+				return nil, err
+			}
+			writeStruct(&f.decls, typeName, embed, fields)
+			f.imports[importMessages] = true
+		}
+	}
+
+	// Registrations, grouped by version
+	for vi := latest; vi >= 0; vi-- {
+		v := g.versions[vi]
+		fmt.Fprintf(&f.inits, "\t// %s\n", v.minecraftVersion)
+		for _, state := range states {
+			s, ok := v.proto.states[state.key][dir]
+			if !ok {
+				continue
+			}
+			packets, err := readPackets(s)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range packets {
+				typeName := pascalSnake(state.key) + kind + pascalSnake(p.name)
+				fmt.Fprintf(&f.inits, "\tmessages.Register%s[%s](constants.%s, constants.%s, 0x%02X)\n", kind, typeName, v.constant(), state.constant, p.id)
+				f.imports[importConstants] = true
+			}
+		}
+		if vi > 0 {
+			fmt.Fprintln(&f.inits)
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintln(&out, "// Code generated by \"go run ./gen\"; DO NOT EDIT.")
+	fmt.Fprintln(&out)
+	fmt.Fprintf(&out, "package %s\n\n", pkg)
+
+	imports := make([]string, 0, len(f.imports))
+	for path := range f.imports {
+		imports = append(imports, path)
+	}
+	slices.Sort(imports)
+	fmt.Fprintln(&out, "import (")
+	for _, path := range imports {
+		fmt.Fprintf(&out, "\t%q\n", path)
+	}
+	fmt.Fprintln(&out, ")")
+	fmt.Fprintln(&out)
+
+	fmt.Fprintln(&out, "func init() {")
+	out.Write(f.inits.Bytes())
+	fmt.Fprintln(&out, "}")
+	fmt.Fprintln(&out)
+	out.Write(f.decls.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+func main() {
+	protocolsPath := flag.String("protocols", "protocol", "directory with a <version>/protocol.json and version.json per protocol version")
+	outDir := flag.String("out", ".", "directory containing the serverbound and clientbound packages")
+	flag.Parse()
+
+	dirs, err := os.ReadDir(*protocolsPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g := &generator{}
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		v, err := readVersion(filepath.Join(*protocolsPath, dir.Name()))
+		if err != nil {
+			log.Fatal("reading protocol: ", err)
+		}
+		g.versions = append(g.versions, v)
+	}
+	if len(g.versions) == 0 {
+		log.Fatal("no protocol versions found in ", *protocolsPath)
+	}
+	slices.SortFunc(g.versions, func(a, b *version) int { return a.protocol - b.protocol })
+
+	for _, d := range directions {
+		src, err := g.generate(d.key, d.pkg, d.embed, d.kind)
+		if err != nil {
+			log.Fatal(err)
+		}
+		path := filepath.Join(*outDir, d.pkg, "packets_gen.go")
+		if err := os.WriteFile(path, src, 0644); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Generated %s for %d protocol versions", path, len(g.versions))
+	}
+}
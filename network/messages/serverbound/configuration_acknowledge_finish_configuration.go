@@ -0,0 +1,74 @@
+package serverbound
+
+import (
+	"log"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/event"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/shared"
+	"github.com/brenfwd/gocraft/world"
+)
+
+func (p *ConfigurationServerboundAcknowledgeFinishConfiguration) Handle(c *shared.ClientShared) error {
+	c.ChangeState(constants.ClientStatePlay)
+
+	player := world.NewPlayer(c.World, c, c.AllegedUUID, c.AllegedUsername)
+	player.Address = network.AddrIP(c.RemoteAddr)
+	player.Permissions = c.Permissions
+	if c.Forwarded != nil {
+		player.Properties = c.Forwarded.Properties
+	}
+	c.Player = player
+	if c.PlayerData != nil {
+		// A player whose data can't be read starts over rather than being
+		// locked out
+		if _, err := c.PlayerData.Load(player); err != nil {
+			log.Printf("Error loading player data of %s: %v", player.Username, err)
+		}
+	}
+	log.Printf("Player %s (%v) joined with entity ID %d", player.Username, player.UUID, player.ID)
+
+	res := clientbound.PlayClientboundLogin{
+		EntityID:            player.ID,
+		IsHardcore:          false,
+		DimensionNames:      []string{"minecraft:overworld"},
+		MaxPlayers:          20,
+		ViewDistance:        data.VarInt(c.World.ViewDistance()),
+		SimulationDistance:  data.VarInt(c.World.ViewDistance()),
+		ReducedDebugInfo:    false,
+		EnableRespawnScreen: true,
+		DoLimitedCrafting:   false,
+		DimensionTypeName:   "minecraft:overworld",
+		DimensionType:       0,
+		DimensionName:       "minecraft:overworld",
+		HashedSeed:          0,
+		GameMode:            byte(player.GameMode),
+		PreviousGameMode:    -1,
+		IsDebug:             false,
+		IsFlat:              true,
+		PortalCooldown:      0,
+		EnforcesSecureChat:  false,
+	}
+	encoded, err := messages.Encode(&res)
+	if err != nil {
+		return err
+	}
+	c.SendPacket(&encoded)
+
+	// Only now that the client knows it is in play can it be sent entities.
+	// The old session may still be in the world if both logged in at once.
+	kickDuplicate(c)
+	c.World.AddPlayer(player)
+
+	yaw, pitch := player.Rotation()
+	if err := player.Teleport(player.Position(), yaw, pitch); err != nil {
+		return err
+	}
+
+	event.Fire(c.Events, &event.Join{Player: player})
+	return nil
+}
@@ -0,0 +1,10 @@
+package serverbound
+
+import (
+	"github.com/brenfwd/gocraft/shared"
+)
+
+func (p *ConfigurationServerboundKeepAlive) Handle(c *shared.ClientShared) error {
+	c.AnswerKeepAlive(p.KeepAliveID)
+	return nil
+}
@@ -0,0 +1,11 @@
+package serverbound
+
+import (
+	"github.com/brenfwd/gocraft/shared"
+)
+
+// Implemented by every serverbound message. Lives here rather than in the
+// messages package so that messages (and clientbound) don't depend on shared.
+type Handler interface {
+	Handle(*shared.ClientShared) error
+}
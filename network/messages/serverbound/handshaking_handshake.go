@@ -1,31 +1,71 @@
 package serverbound
 
 import (
+	"errors"
 	"fmt"
+	"log"
 
 	"github.com/brenfwd/gocraft/constants"
 	"github.com/brenfwd/gocraft/data"
-	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/forwarding"
 	"github.com/brenfwd/gocraft/shared"
 )
 
-func init() {
-	messages.RegisterServerbound[HandshakingServerboundHandshake](constants.ClientStateHandshaking, 0x00)
-}
-
-type HandshakingServerboundHandshake struct {
-	messages.Serverbound
-	ProtocolVersion data.VarInt
-	ServerAddress   string
-	ServerPort      uint16
-	NextState       data.VarInt
-}
+// Longest server address vanilla accepts. BungeeCord's forwarded details don't
+// fit in it.
+const maxServerAddress = 255
 
 func (p *HandshakingServerboundHandshake) Handle(c *shared.ClientShared) error {
+	if len(p.ServerAddress) > maxServerAddress && c.Forwarding.Mode != forwarding.ModeBungeeCord {
+		return fmt.Errorf("server address too long (%d bytes)", len(p.ServerAddress))
+	}
+
 	nextStateDecode, validState := constants.ClientStateFromInt(int(p.NextState))
 	if !validState {
 		return fmt.Errorf("invalid next state %v", p.NextState)
 	}
-	c.ChangeState(nextStateDecode)
+
+	protocol := constants.ProtocolVersion(p.ProtocolVersion)
+	if protocol.Supported() {
+		c.Protocol = protocol
+	}
+
+	switch nextStateDecode {
+	case constants.ClientStateStatus:
+		// Unsupported clients still get a status response, and are shown the
+		// server as incompatible.
+		c.ChangeState(nextStateDecode)
+	case constants.ClientStateLogin, constants.ClientStateTransfer:
+		c.ChangeState(constants.ClientStateLogin)
+		if !protocol.Supported() {
+			c.Disconnect(outdatedMessage(protocol))
+			return nil
+		}
+		if c.Forwarding.Mode == forwarding.ModeBungeeCord {
+			_, info, err := forwarding.ParseBungeeCord(p.ServerAddress)
+			if err == nil && !c.Forwarding.FromProxy(network.AddrIP(c.RemoteAddr)) {
+				// Anyone else could claim to be any player
+				err = errors.New("not from a configured proxy")
+			}
+			if err != nil {
+				log.Printf("BungeeCord forwarding from %s: %v", c.RemoteAddr, err)
+				// Same wording as Spigot
+				c.Disconnect(data.MakeChat().SetText("If you wish to use IP forwarding, please enable it in your BungeeCord config as well!"))
+				return nil
+			}
+			c.Forward(info)
+		}
+	default:
+		return fmt.Errorf("invalid next state %v", nextStateDecode)
+	}
 	return nil
 }
+
+// Same wording as vanilla.
+func outdatedMessage(protocol constants.ProtocolVersion) *data.Chat {
+	if protocol < constants.ProtocolOldest {
+		return data.MakeChat().SetText(fmt.Sprintf("Outdated client! Please use %s", constants.SupportedVersionsName()))
+	}
+	return data.MakeChat().SetText(fmt.Sprintf("Outdated server! I'm still on %s", constants.SupportedVersionsName()))
+}
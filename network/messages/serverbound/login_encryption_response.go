@@ -4,24 +4,15 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/event"
+	"github.com/brenfwd/gocraft/network"
 	"github.com/brenfwd/gocraft/network/messages"
 	"github.com/brenfwd/gocraft/network/messages/clientbound"
 	"github.com/brenfwd/gocraft/shared"
 )
 
-func init() {
-	messages.RegisterServerbound[LoginEncryptionResponse](constants.ClientStateLogin, 0x01)
-}
-
-type LoginEncryptionResponse struct {
-	messages.Serverbound
-	SharedSecret []byte `message:"length:varint"`
-	VerifyToken  []byte `message:"length:varint"`
-}
-
-func (p *LoginEncryptionResponse) Handle(c *shared.ClientShared) error {
-	fmt.Printf("%+v\n", p)
+func (p *LoginServerboundEncryptionResponse) Handle(c *shared.ClientShared) error {
 	// SharedSecret and VerifyToken are encrypted using the server public key
 	decSharedSecret := p.SharedSecret
 	c.ListenerKeypair.DecryptWithPrivateKey(&decSharedSecret)
@@ -41,16 +32,88 @@ func (p *LoginEncryptionResponse) Handle(c *shared.ClientShared) error {
 		}
 	}
 
-	log.Printf("Encryption response: using shared secret %x", c.SharedSecret)
-
 	// Enable encryption
 	c.EnableEncryption()
 
+	return queryLogin(c)
+}
+
+// Fires LoginQuery and sends the login plugin requests its handlers added, then
+// finishes the login once the client answered all of them.
+func queryLogin(c *shared.ClientShared) error {
+	query := event.LoginQuery{
+		RemoteAddr: c.RemoteAddr,
+		Username:   c.AllegedUsername,
+		UUID:       c.AllegedUUID,
+	}
+	event.Fire(c.Events, &query)
+	if len(query.Requests) == 0 {
+		return finishLogin(c)
+	}
+
+	pending := len(query.Requests)
+	rejected := false
+	for _, request := range query.Requests {
+		timeout := request.Timeout
+		if timeout <= 0 {
+			timeout = loginPluginTimeout
+		}
+		err := c.SendLoginPluginRequest(request.Channel, request.Data, timeout, func(response event.LoginPluginResponse) error {
+			if rejected {
+				return nil
+			}
+			if request.OnResponse != nil {
+				if reason := request.OnResponse(response); reason != nil {
+					rejected = true
+					c.Disconnect(reason)
+					return nil
+				}
+			}
+			pending--
+			if pending == 0 {
+				return finishLogin(c)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Checks the whitelist and bans, fires Login, then sends Login Success.
+func finishLogin(c *shared.ClientShared) error {
+	if c.Access != nil {
+		if reason := c.Access.CheckLogin(c.AllegedUUID, network.AddrIP(c.RemoteAddr)); reason != nil {
+			log.Printf("Disconnecting %s (%s): %s", c.AllegedUsername, c.RemoteAddr, reason.PlainText())
+			c.Disconnect(reason)
+			return nil
+		}
+	}
+
+	login := event.Login{
+		RemoteAddr:  c.RemoteAddr,
+		Username:    c.AllegedUsername,
+		UUID:        c.AllegedUUID,
+		KickMessage: data.MakeChat().SetText("You are not allowed to join this server"),
+	}
+	if !event.Fire(c.Events, &login) {
+		c.Disconnect(login.KickMessage)
+		return nil
+	}
+	kickDuplicate(c)
+
 	// Send login success
-	res := clientbound.LoginSuccess{
+	res := clientbound.LoginClientboundSuccess{
 		UUID:       c.AllegedUUID,
 		Username:   c.AllegedUsername,
-		Properties: []clientbound.LoginSuccess_Property{},
+		Properties: []clientbound.LoginClientboundSuccess_Property{},
+	}
+	if c.Forwarded != nil {
+		for _, p := range c.Forwarded.Properties {
+			res.Properties = append(res.Properties, clientbound.LoginClientboundSuccess_Property(p))
+		}
 	}
 	encoded, err := messages.Encode(&res)
 	if err != nil {
@@ -60,3 +123,19 @@ func (p *LoginEncryptionResponse) Handle(c *shared.ClientShared) error {
 
 	return nil
 }
+
+// Like vanilla, a player logging in again replaces their old session.
+func kickDuplicate(c *shared.ClientShared) {
+	if old := c.World.PlayerByUUID(c.AllegedUUID); old != nil && old != c.Player {
+		log.Printf("%s logged in again from %s, kicking the old session", c.AllegedUsername, c.RemoteAddr)
+		// Saved now so the new session loads the latest state. The old one
+		// isn't saved again, or it could overwrite what the new one saves.
+		if c.PlayerData != nil {
+			if err := c.PlayerData.Save(old); err != nil {
+				log.Printf("Error saving player data of %s: %v", old.Username, err)
+			}
+		}
+		old.SetReplaced()
+		old.Kick(data.MakeChat().SetText("You logged in from another location"))
+	}
+}
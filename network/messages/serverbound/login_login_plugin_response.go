@@ -0,0 +1,14 @@
+package serverbound
+
+import (
+	"github.com/brenfwd/gocraft/event"
+	"github.com/brenfwd/gocraft/shared"
+)
+
+func (p *LoginServerboundLoginPluginResponse) Handle(c *shared.ClientShared) error {
+	response := event.LoginPluginResponse{Understood: p.Data != nil}
+	if p.Data != nil {
+		response.Data = *p.Data
+	}
+	return c.AnswerLoginPluginRequest(int32(p.MessageID), response)
+}
@@ -2,32 +2,77 @@ package serverbound
 
 import (
 	"log"
+	"time"
 
-	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/event"
+	"github.com/brenfwd/gocraft/network/forwarding"
 	"github.com/brenfwd/gocraft/network/messages"
 	"github.com/brenfwd/gocraft/network/messages/clientbound"
 	"github.com/brenfwd/gocraft/shared"
-	"github.com/google/uuid"
 )
 
-func init() {
-	messages.RegisterServerbound[LoginServerboundLoginStart](constants.ClientStateLogin, 0x00)
-}
+// Time clients have to answer a login plugin request, unless the request sets
+// its own.
+const loginPluginTimeout = 10 * time.Second
+
+func (p *LoginServerboundLoginStart) Handle(c *shared.ClientShared) error {
+	if !data.ValidUsername(p.Name) {
+		c.Disconnect(data.MakeChat().SetText("Invalid username: use 3 to 16 letters, digits or underscores"))
+		return nil
+	}
+	c.AllegedUsername = p.Name
+	// The UUID the client sent is ignored, like vanilla does in offline mode
+	if c.Forwarded == nil {
+		c.AllegedUUID = data.OfflineUUID(p.Name)
+	}
+
+	if c.Forwarding.Mode == forwarding.ModeVelocity {
+		// Login continues once Velocity answers
+		return c.SendLoginPluginRequest(forwarding.VelocityChannel, forwarding.VelocityRequest(), loginPluginTimeout, func(response event.LoginPluginResponse) error {
+			if response.TimedOut {
+				c.Disconnect(data.MakeChat().SetText("Timed out waiting for player details from Velocity"))
+				return nil
+			}
+			// Clients that connect directly don't understand the request
+			if !response.Understood {
+				c.Disconnect(data.MakeChat().SetText("This server requires you to connect with Velocity."))
+				return nil
+			}
+			info, err := forwarding.ParseVelocity(c.Forwarding.Secret, response.Data)
+			if err != nil {
+				log.Printf("Velocity forwarding from %s: %v", c.RemoteAddr, err)
+				c.Disconnect(data.MakeChat().SetText("Unable to verify player details"))
+				return nil
+			}
+			c.Forward(info)
+			return startLogin(c)
+		})
+	}
 
-type LoginServerboundLoginStart struct {
-	messages.Serverbound
-	Name       string
-	PlayerUUID uuid.UUID
+	return startLogin(c)
 }
 
-func (p *LoginServerboundLoginStart) Handle(c *shared.ClientShared) error {
-	log.Println(p)
+// Fires PreLogin, then either asks the client to enable encryption or, if a
+// proxy has already authenticated it, lets it in.
+func startLogin(c *shared.ClientShared) error {
+	preLogin := event.PreLogin{
+		RemoteAddr:  c.RemoteAddr,
+		Username:    c.AllegedUsername,
+		UUID:        c.AllegedUUID,
+		KickMessage: data.MakeChat().SetText("You are not allowed to join this server"),
+	}
+	if !event.Fire(c.Events, &preLogin) {
+		c.Disconnect(preLogin.KickMessage)
+		return nil
+	}
 
-	// TODO: validate username with regex...
-	c.AllegedUsername = p.Name
-	c.AllegedUUID = p.PlayerUUID
+	// Proxies don't encrypt their connection to the server
+	if c.Forwarding.Mode != forwarding.ModeNone {
+		return queryLogin(c)
+	}
 
-	res := clientbound.LoginEncryptionRequest{
+	res := clientbound.LoginClientboundEncryptionRequest{
 		ServerID:           "",
 		PublicKey:          c.ListenerKeypair.PublicKey,
 		VerifyToken:        c.EncryptionVerifyToken[:],
@@ -39,16 +84,5 @@ func (p *LoginServerboundLoginStart) Handle(c *shared.ClientShared) error {
 	}
 	c.SendPacket(&encoded)
 
-	// res := clientbound.LoginSuccess{
-	// 	UUID:       c.AllegedUUID,
-	// 	Username:   c.AllegedUsername,
-	// 	Properties: []clientbound.LoginSuccess_Property{},
-	// }
-	// encoded, err := messages.Encode(&res)
-	// if err != nil {
-	// 	return err
-	// }
-	// c.SendPacket(&encoded)
-
 	return nil
 }
@@ -0,0 +1,14 @@
+package serverbound
+
+import (
+	"github.com/brenfwd/gocraft/shared"
+)
+
+// TODO: argument signatures are ignored, like chat message signatures.
+func (p *PlayServerboundChatCommand) Handle(c *shared.ClientShared) error {
+	if c.Commands == nil {
+		return nil
+	}
+	c.Commands.Execute(c.Player, p.Command)
+	return nil
+}
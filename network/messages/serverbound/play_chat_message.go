@@ -0,0 +1,35 @@
+package serverbound
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/event"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/shared"
+)
+
+// TODO: signatures are ignored; messages are broadcast unsigned as system chat.
+func (p *PlayServerboundChatMessage) Handle(c *shared.ClientShared) error {
+	chat := event.Chat{Player: c.Player, Message: p.Message}
+	if !event.Fire(c.Events, &chat) {
+		return nil
+	}
+
+	log.Printf("<%s> %s", c.Player.Username, chat.Message)
+
+	content := data.MakeChat().SetText(fmt.Sprintf("<%s> %s", c.Player.Username, chat.Message))
+	res := clientbound.PlayClientboundSystemChatMessage{
+		ContentJSON: *content,
+		Content:     content.ToNBT(nil),
+		Overlay:     false,
+	}
+	encoded, err := messages.Encode(&res)
+	if err != nil {
+		return err
+	}
+	c.World.BroadcastExcept(nil, &encoded)
+	return nil
+}
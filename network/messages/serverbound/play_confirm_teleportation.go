@@ -0,0 +1,9 @@
+package serverbound
+
+import (
+	"github.com/brenfwd/gocraft/shared"
+)
+
+func (p *PlayServerboundConfirmTeleportation) Handle(c *shared.ClientShared) error {
+	return c.Player.ConfirmTeleport(int32(p.TeleportID))
+}
@@ -0,0 +1,10 @@
+package serverbound
+
+import (
+	"github.com/brenfwd/gocraft/shared"
+)
+
+func (p *PlayServerboundKeepAlive) Handle(c *shared.ClientShared) error {
+	c.AnswerKeepAlive(p.KeepAliveID)
+	return nil
+}
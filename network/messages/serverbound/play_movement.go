@@ -0,0 +1,23 @@
+package serverbound
+
+import (
+	"github.com/brenfwd/gocraft/event"
+	"github.com/brenfwd/gocraft/shared"
+	"github.com/brenfwd/gocraft/world"
+)
+
+// Shared by the Set Player Position/Rotation/On Ground handlers: fires the Move
+// event and applies the movement unless it was cancelled.
+func handleMovement(c *shared.ClientShared, m world.Movement) error {
+	if m.HasPosition || m.HasRotation {
+		from := c.Player.Position()
+		to := from
+		if m.HasPosition {
+			to = m.Position
+		}
+		if !event.Fire(c.Events, &event.Move{Player: c.Player, From: from, To: to}) {
+			return c.Player.Resync()
+		}
+	}
+	return c.Player.Move(m)
+}
@@ -0,0 +1,52 @@
+package serverbound
+
+import (
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/event"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/shared"
+	"github.com/brenfwd/gocraft/world"
+	"github.com/brenfwd/gocraft/world/block"
+)
+
+const (
+	PlayerActionStartedDigging   data.VarInt = 0
+	PlayerActionCancelledDigging data.VarInt = 1
+	PlayerActionFinishedDigging  data.VarInt = 2
+)
+
+func (p *PlayServerboundPlayerAction) Handle(c *shared.ClientShared) error {
+	// Creative players break blocks instantly and never send "finished"; like
+	// vanilla, one they send anyway is ignored rather than breaking again
+	creative := c.Player.GameMode == world.GameModeCreative
+	if p.Status == PlayerActionStartedDigging && creative || p.Status == PlayerActionFinishedDigging && !creative {
+		if err := breakBlock(c, p.Location); err != nil {
+			return err
+		}
+	}
+	return acknowledgeBlockChange(c, p.Sequence)
+}
+
+func breakBlock(c *shared.ClientShared, pos data.Position) error {
+	if !c.Player.CanReach(pos) {
+		return c.World.SendBlock(c.Player, pos)
+	}
+	breakEvent := event.BlockBreak{Player: c.Player, Position: pos, State: c.World.Block(pos)}
+	if !event.Fire(c.Events, &breakEvent) {
+		return c.World.SendBlock(c.Player, pos)
+	}
+	return c.World.SetBlock(pos, block.Air.Block().Default())
+}
+
+// Tells the client the server has processed its block changes up to sequence,
+// so it can stop predicting them.
+func acknowledgeBlockChange(c *shared.ClientShared, sequence data.VarInt) error {
+	res := clientbound.PlayClientboundAcknowledgeBlockChange{SequenceID: sequence}
+	encoded, err := messages.Encode(&res)
+	if err != nil {
+		return err
+	}
+	c.SendPacket(&encoded)
+	return nil
+}
@@ -0,0 +1,12 @@
+package serverbound
+
+import (
+	"github.com/brenfwd/gocraft/shared"
+	"github.com/brenfwd/gocraft/world"
+)
+
+func (p *PlayServerboundSetPlayerOnGround) Handle(c *shared.ClientShared) error {
+	return handleMovement(c, world.Movement{
+		OnGround: p.OnGround,
+	})
+}
@@ -0,0 +1,15 @@
+package serverbound
+
+import (
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/shared"
+	"github.com/brenfwd/gocraft/world"
+)
+
+func (p *PlayServerboundSetPlayerPosition) Handle(c *shared.ClientShared) error {
+	return handleMovement(c, world.Movement{
+		Position:    data.Vec3{X: p.X, Y: p.FeetY, Z: p.Z},
+		OnGround:    p.OnGround,
+		HasPosition: true,
+	})
+}
@@ -0,0 +1,18 @@
+package serverbound
+
+import (
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/shared"
+	"github.com/brenfwd/gocraft/world"
+)
+
+func (p *PlayServerboundSetPlayerPositionAndRotation) Handle(c *shared.ClientShared) error {
+	return handleMovement(c, world.Movement{
+		Position:    data.Vec3{X: p.X, Y: p.FeetY, Z: p.Z},
+		Yaw:         p.Yaw,
+		Pitch:       p.Pitch,
+		OnGround:    p.OnGround,
+		HasPosition: true,
+		HasRotation: true,
+	})
+}
@@ -0,0 +1,15 @@
+package serverbound
+
+import (
+	"github.com/brenfwd/gocraft/shared"
+	"github.com/brenfwd/gocraft/world"
+)
+
+func (p *PlayServerboundSetPlayerRotation) Handle(c *shared.ClientShared) error {
+	return handleMovement(c, world.Movement{
+		Yaw:         p.Yaw,
+		Pitch:       p.Pitch,
+		OnGround:    p.OnGround,
+		HasRotation: true,
+	})
+}
@@ -0,0 +1,43 @@
+package serverbound
+
+import (
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/event"
+	"github.com/brenfwd/gocraft/shared"
+)
+
+// Offsets of the block faces, in the order of the Face field.
+var faceOffsets = [...]data.Position{
+	{X: 0, Y: -1, Z: 0},
+	{X: 0, Y: 1, Z: 0},
+	{X: 0, Y: 0, Z: -1},
+	{X: 0, Y: 0, Z: 1},
+	{X: -1, Y: 0, Z: 0},
+	{X: 1, Y: 0, Z: 0},
+}
+
+func (p *PlayServerboundUseItemOn) Handle(c *shared.ClientShared) error {
+	if p.Face < 0 || int(p.Face) >= len(faceOffsets) {
+		return acknowledgeBlockChange(c, p.Sequence)
+	}
+	offset := faceOffsets[p.Face]
+	pos := data.Position{X: p.Location.X + offset.X, Y: p.Location.Y + offset.Y, Z: p.Location.Z + offset.Z}
+	if !c.Player.CanReach(p.Location) || !c.Player.CanReach(pos) {
+		if err := c.World.SendBlock(c.Player, pos); err != nil {
+			return err
+		}
+		return acknowledgeBlockChange(c, p.Sequence)
+	}
+
+	// Held items aren't tracked, so there is nothing to place unless an event
+	// handler picks a block
+	placeEvent := event.BlockPlace{Player: c.Player, Position: pos}
+	if !event.Fire(c.Events, &placeEvent) || placeEvent.State.Block == nil {
+		if err := c.World.SendBlock(c.Player, pos); err != nil {
+			return err
+		}
+	} else if err := c.World.SetBlock(pos, placeEvent.State); err != nil {
+		return err
+	}
+	return acknowledgeBlockChange(c, p.Sequence)
+}
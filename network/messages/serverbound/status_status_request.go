@@ -2,29 +2,43 @@ package serverbound
 
 import (
 	_ "embed"
+	"encoding/json"
 
 	"github.com/brenfwd/gocraft/constants"
 	"github.com/brenfwd/gocraft/data"
-	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/event"
 	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
 	"github.com/brenfwd/gocraft/shared"
 )
 
 //go:embed tempresponse.json
 var tempresponse string
 
-func init() {
-	messages.RegisterServerbound[StatusServerboundStatusRequest](constants.ClientStateStatus, 0x00)
-}
+func (p *StatusServerboundStatusRequest) Handle(c *shared.ClientShared) error {
+	var response data.StatusResponse
+	if err := json.Unmarshal([]byte(tempresponse), &response); err != nil {
+		return err
+	}
+	response.Players.Online = len(c.World.Players())
+	// Supported clients get their own protocol version back so that they show
+	// the server as compatible.
+	response.Version = data.StatusVersion{Name: constants.SupportedVersionsName(), Protocol: int(c.Protocol)}
 
-type StatusServerboundStatusRequest struct {
-	messages.Serverbound
-}
+	if !event.Fire(c.Events, &event.StatusPing{RemoteAddr: c.RemoteAddr, Response: &response}) {
+		c.Disconnect(nil)
+		return nil
+	}
 
-func (p *StatusServerboundStatusRequest) Handle(c *shared.ClientShared) error {
-	var wbuf data.Buffer
-	wbuf.WriteString(tempresponse)
-	outPacket := network.Packet{Id: 0, Body: wbuf.Raw}
-	c.SendPacket(&outPacket)
+	encoded, err := json.Marshal(&response)
+	if err != nil {
+		return err
+	}
+
+	res, err := messages.Encode(&clientbound.StatusClientboundStatusResponse{Response: string(encoded)})
+	if err != nil {
+		return err
+	}
+	c.SendPacket(&res)
 	return nil
 }
@@ -1,66 +1,120 @@
 package network
 
 import (
+	"bytes"
+	"compress/zlib"
 	"errors"
+	"io"
 
+	"github.com/brenfwd/gocraft/constants"
 	"github.com/brenfwd/gocraft/data"
 )
 
 type Packet struct {
 	Id   int
 	Body []byte
+	// For encoded clientbound messages: the message and the protocol version it
+	// was encoded for, so it can be encoded again for a client on another
+	// version. Nil for received packets.
+	Message  any
+	Protocol constants.ProtocolVersion
+	// The pooled buffer Body points into, for received packets
+	pooled *[]byte
 }
 
-type PacketUnmarshaller struct {
-	buffer data.Buffer
+// Returns the pooled buffer of a received packet, so it can be reused for the
+// next one. Body must not be used afterwards. Does nothing for other packets.
+func (p *Packet) Release() {
+	if p.pooled != nil {
+		putFrameBuffer(p.pooled)
+		p.pooled = nil
+		p.Body = nil
+	}
 }
 
-func (pu *PacketUnmarshaller) Unmarshal(newData []byte) ([]Packet, error) {
-	pu.buffer.Write(newData)
-	if pu.buffer.Length() > 4*1024*1024 {
-		return []Packet{}, errors.New("buffer grew too big")
+// Appends the packet to dst as a length-prefixed frame. With a threshold of
+// zero or more it is in the compressed format, used once compression has been
+// enabled with Set Compression: bodies of at least threshold bytes are zlib
+// compressed. A negative threshold means compression is disabled.
+func (p *Packet) AppendFrame(dst []byte, threshold int) ([]byte, error) {
+	dataLength := varIntSize(p.Id) + len(p.Body)
+	if threshold < 0 {
+		dst = appendVarInt(dst, dataLength)
+		dst = appendVarInt(dst, p.Id)
+		return append(dst, p.Body...), nil
+	}
+	if dataLength < threshold {
+		// A data length of 0 marks the body as uncompressed
+		dst = appendVarInt(dst, 1+dataLength)
+		dst = append(dst, 0)
+		dst = appendVarInt(dst, p.Id)
+		return append(dst, p.Body...), nil
 	}
 
-	var packets []Packet
-	for !pu.buffer.Empty() {
-		save := pu.buffer.Raw
+	buf := getFrameBuffer()
+	defer putFrameBuffer(buf)
+	compressed := appendWriter{buf: (*buf)[:0]}
+	w := zlibWriterPool.Get().(*zlib.Writer)
+	defer zlibWriterPool.Put(w)
+	w.Reset(&compressed)
+	var id [5]byte
+	if _, err := w.Write(appendVarInt(id[:0], p.Id)); err != nil {
+		return dst, err
+	}
+	if _, err := w.Write(p.Body); err != nil {
+		return dst, err
+	}
+	if err := w.Close(); err != nil {
+		return dst, err
+	}
+	*buf = compressed.buf
 
-		length, _, err := pu.buffer.ReadVarInt()
-		if err != nil {
-			pu.buffer.Raw = save
-			break
-		}
+	dst = appendVarInt(dst, varIntSize(dataLength)+len(compressed.buf))
+	dst = appendVarInt(dst, dataLength)
+	return append(dst, compressed.buf...), nil
+}
 
-		if length < 1 {
-			return []Packet{}, errors.New("invalid packet length")
-		}
+// Marshals the packet in the compressed format, see AppendFrame.
+func (p *Packet) MarshalCompressed(threshold int) ([]byte, error) {
+	return p.AppendFrame(nil, threshold)
+}
 
-		if int(length) > pu.buffer.Length() {
-			pu.buffer.Raw = save
-			break
-		}
+// Packets can't be larger than this, compressed or not.
+const MaxPacketLength = 1<<21 - 1
 
-		packetId, packetIdBytes, err := pu.buffer.ReadVarInt()
+// Parses the contents of a frame (everything after the length prefix). If
+// compressed is set the frame is in the format written by MarshalCompressed.
+func UnmarshalFrame(frame []byte, compressed bool) (Packet, error) {
+	buf := data.NewBufferFromBytes(frame)
+	if compressed {
+		dataLength, _, err := buf.ReadVarInt()
 		if err != nil {
-			return []Packet{}, err
+			return Packet{}, err
 		}
-
-		body, err := pu.buffer.Read(int(length) - packetIdBytes)
-		if err != nil {
-			return []Packet{}, err
+		if dataLength < 0 || dataLength > MaxPacketLength {
+			return Packet{}, errors.New("invalid uncompressed packet length")
+		}
+		if dataLength > 0 {
+			r, err := zlib.NewReader(bytes.NewReader(buf.Raw))
+			if err != nil {
+				return Packet{}, err
+			}
+			defer r.Close()
+			body := make([]byte, dataLength)
+			if _, err := io.ReadFull(r, body); err != nil {
+				return Packet{}, err
+			}
+			buf = data.NewBufferFromBytes(body)
 		}
-
-		packets = append(packets, Packet{Id: int(packetId), Body: body})
 	}
 
-	return packets, nil
+	packetId, _, err := buf.ReadVarInt()
+	if err != nil {
+		return Packet{}, err
+	}
+	return Packet{Id: int(packetId), Body: buf.Raw}, nil
 }
 
 func (p *Packet) Marshal() ([]byte, error) {
-	var headWBuf data.Buffer
-	var bodyBuf data.Buffer
-	bodyBuf.WriteVarInt(data.VarInt(p.Id))
-	bodyBuf.Write(p.Body)
-	headWBuf.WriteVarInt(data.VarInt(bodyBuf.Length()))
-	return append(headWBuf.Raw, bodyBuf.Raw...), nil
+	return p.AppendFrame(nil, -1)
 }
@@ -0,0 +1,47 @@
+package network
+
+import (
+	"compress/zlib"
+	"sync"
+)
+
+// Buffers for frames read and written, reused to avoid allocating for every
+// packet. Buffers are handed out empty.
+var framePool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 1024)
+		return &b
+	},
+}
+
+// Buffers that grew larger than this (e.g. for chunk data) are dropped instead
+// of going back to the pool.
+const maxPooledBuffer = 64 * 1024
+
+func getFrameBuffer() *[]byte {
+	return framePool.Get().(*[]byte)
+}
+
+func putFrameBuffer(b *[]byte) {
+	if cap(*b) > maxPooledBuffer {
+		return
+	}
+	*b = (*b)[:0]
+	framePool.Put(b)
+}
+
+var zlibWriterPool = sync.Pool{
+	New: func() any {
+		return zlib.NewWriter(nil)
+	},
+}
+
+// An io.Writer appending to a byte slice.
+type appendWriter struct {
+	buf []byte
+}
+
+func (w *appendWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	return len(p), nil
+}
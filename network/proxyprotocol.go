@@ -0,0 +1,155 @@
+package network
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parsing of HAProxy's PROXY protocol headers, which load balancers send
+// before the proxied stream to pass on the client's address.
+// See https://www.haproxy.org/download/2.9/doc/proxy-protocol.txt
+
+var ErrInvalidProxyHeader = errors.New("invalid PROXY protocol header")
+
+// Time a trusted proxy has to send the header after connecting.
+const proxyHeaderTimeout = 5 * time.Second
+
+var proxyV2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+const (
+	// Longest possible v1 header, including the CRLF
+	proxyV1MaxLength = 107
+	// The v2 signature followed by the version, family and length
+	proxyV2HeaderLength = 16
+)
+
+// A connection with the client address taken from a PROXY protocol header.
+type proxiedConn struct {
+	net.Conn
+	remote net.Addr
+}
+
+func (c *proxiedConn) RemoteAddr() net.Addr {
+	return c.remote
+}
+
+// Reads the PROXY protocol header (v1 or v2) from the start of conn. The result
+// reports the client's address as its RemoteAddr; if the header doesn't carry
+// one (e.g. the proxy's own health checks), conn is returned as is.
+func readProxyHeader(conn net.Conn) (net.Conn, error) {
+	conn.SetReadDeadline(time.Now().Add(proxyHeaderTimeout))
+	defer conn.SetReadDeadline(time.Time{})
+
+	// Both versions' headers are at least this long: "PROXY UNKNOWN\r\n" is 15
+	// bytes
+	start := make([]byte, len(proxyV2Signature))
+	if _, err := io.ReadFull(conn, start); err != nil {
+		return nil, err
+	}
+
+	var addr netip.AddrPort
+	var err error
+	switch {
+	case bytes.Equal(start, proxyV2Signature):
+		addr, err = readProxyV2(conn)
+	case bytes.HasPrefix(start, []byte("PROXY ")):
+		addr, err = readProxyV1(conn, start)
+	default:
+		return nil, ErrInvalidProxyHeader
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !addr.IsValid() {
+		return conn, nil
+	}
+	return &proxiedConn{Conn: conn, remote: net.TCPAddrFromAddrPort(addr)}, nil
+}
+
+// Reads the rest of a v1 header, which is a line like
+// "PROXY TCP4 192.0.2.1 192.0.2.2 56324 25565\r\n".
+func readProxyV1(conn net.Conn, start []byte) (netip.AddrPort, error) {
+	// The header is read a byte at a time so nothing after it is consumed
+	line := start
+	one := make([]byte, 1)
+	for !bytes.HasSuffix(line, []byte("\r\n")) {
+		if len(line) >= proxyV1MaxLength {
+			return netip.AddrPort{}, ErrInvalidProxyHeader
+		}
+		if _, err := io.ReadFull(conn, one); err != nil {
+			return netip.AddrPort{}, err
+		}
+		line = append(line, one[0])
+	}
+
+	fields := strings.Split(string(line[:len(line)-2]), " ")
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return netip.AddrPort{}, nil
+	}
+	if len(fields) != 6 || (fields[1] != "TCP4" && fields[1] != "TCP6") {
+		return netip.AddrPort{}, ErrInvalidProxyHeader
+	}
+	ip, err := netip.ParseAddr(fields[2])
+	if err != nil || ip.Is4() != (fields[1] == "TCP4") {
+		return netip.AddrPort{}, ErrInvalidProxyHeader
+	}
+	port, err := strconv.ParseUint(fields[4], 10, 16)
+	if err != nil {
+		return netip.AddrPort{}, ErrInvalidProxyHeader
+	}
+	return netip.AddrPortFrom(ip, uint16(port)), nil
+}
+
+// Reads the rest of a v2 header, after its signature.
+func readProxyV2(conn net.Conn) (netip.AddrPort, error) {
+	header := make([]byte, proxyV2HeaderLength-len(proxyV2Signature))
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return netip.AddrPort{}, err
+	}
+	versionCommand, family := header[0], header[1]
+	if versionCommand>>4 != 2 {
+		return netip.AddrPort{}, ErrInvalidProxyHeader
+	}
+	// Addresses are followed by optional TLVs, which are skipped
+	body := make([]byte, binary.BigEndian.Uint16(header[2:]))
+	if _, err := io.ReadFull(conn, body); err != nil {
+		return netip.AddrPort{}, err
+	}
+
+	switch versionCommand & 0x0F {
+	case 0x0:
+		// LOCAL: the connection was made by the proxy itself
+		return netip.AddrPort{}, nil
+	case 0x1:
+		// PROXY
+	default:
+		return netip.AddrPort{}, ErrInvalidProxyHeader
+	}
+
+	switch family {
+	case 0x11, 0x12:
+		// TCP or UDP over IPv4: source and destination addresses, then ports
+		if len(body) < 12 {
+			return netip.AddrPort{}, ErrInvalidProxyHeader
+		}
+		ip := netip.AddrFrom4([4]byte(body[0:4]))
+		return netip.AddrPortFrom(ip, binary.BigEndian.Uint16(body[8:])), nil
+	case 0x21, 0x22:
+		// TCP or UDP over IPv6
+		if len(body) < 36 {
+			return netip.AddrPort{}, ErrInvalidProxyHeader
+		}
+		ip := netip.AddrFrom16([16]byte(body[0:16]))
+		return netip.AddrPortFrom(ip, binary.BigEndian.Uint16(body[32:])), nil
+	default:
+		// Unspecified or Unix sockets, which carry no usable address
+		return netip.AddrPort{}, nil
+	}
+}
@@ -0,0 +1,160 @@
+package network
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+)
+
+// A v2 header with the given version/command and family bytes and body.
+func proxyV2(versionCommand, family byte, body []byte) string {
+	header := append([]byte{}, proxyV2Signature...)
+	header = append(header, versionCommand, family)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(body)))
+	return string(append(header, body...))
+}
+
+// The body of a v2 TCP over IPv4 header, followed by extra (e.g. TLVs).
+func proxyV2IPv4(src, dst string, srcPort, dstPort uint16, extra ...byte) []byte {
+	srcIP, dstIP := netip.MustParseAddr(src).As4(), netip.MustParseAddr(dst).As4()
+	body := append(srcIP[:], dstIP[:]...)
+	body = binary.BigEndian.AppendUint16(body, srcPort)
+	body = binary.BigEndian.AppendUint16(body, dstPort)
+	return append(body, extra...)
+}
+
+func proxyV2IPv6(src, dst string, srcPort, dstPort uint16) []byte {
+	srcIP, dstIP := netip.MustParseAddr(src).As16(), netip.MustParseAddr(dst).As16()
+	body := append(srcIP[:], dstIP[:]...)
+	body = binary.BigEndian.AppendUint16(body, srcPort)
+	return binary.BigEndian.AppendUint16(body, dstPort)
+}
+
+func TestReadProxyHeader(t *testing.T) {
+	// An AUTHORITY TLV, which is skipped
+	tlv := []byte{0x02, 0x00, 0x03, 'f', 'o', 'o'}
+	tests := []struct {
+		name   string
+		header string
+		// The remote address after reading the header; unset if the
+		// connection's own is kept
+		want string
+		err  error
+	}{
+		{"V1TCP4", "PROXY TCP4 192.0.2.1 192.0.2.2 56324 25565\r\n", "192.0.2.1:56324", nil},
+		{"V1TCP6", "PROXY TCP6 2001:db8::1 2001:db8::2 56324 25565\r\n", "[2001:db8::1]:56324", nil},
+		{"V1Unknown", "PROXY UNKNOWN\r\n", "", nil},
+		{"V1UnknownWithAddresses", "PROXY UNKNOWN 192.0.2.1 192.0.2.2 56324 25565\r\n", "", nil},
+		{"V2TCP4", proxyV2(0x21, 0x11, proxyV2IPv4("192.0.2.1", "192.0.2.2", 56324, 25565)), "192.0.2.1:56324", nil},
+		{"V2TCP4WithTLVs", proxyV2(0x21, 0x11, proxyV2IPv4("192.0.2.1", "192.0.2.2", 56324, 25565, tlv...)), "192.0.2.1:56324", nil},
+		{"V2TCP6", proxyV2(0x21, 0x21, proxyV2IPv6("2001:db8::1", "2001:db8::2", 56324, 25565)), "[2001:db8::1]:56324", nil},
+		{"V2Local", proxyV2(0x20, 0x00, nil), "", nil},
+		{"V2LocalWithAddresses", proxyV2(0x20, 0x11, proxyV2IPv4("192.0.2.1", "192.0.2.2", 56324, 25565)), "", nil},
+		{"V2Unix", proxyV2(0x21, 0x31, make([]byte, 216)), "", nil},
+
+		{"NoHeader", "\x10\x00\xfe\x05\x09localhost\x63\xdd\x01", "", ErrInvalidProxyHeader},
+		{"V1BadProtocol", "PROXY UDP4 192.0.2.1 192.0.2.2 56324 25565\r\n", "", ErrInvalidProxyHeader},
+		{"V1MissingPort", "PROXY TCP4 192.0.2.1 192.0.2.2 56324\r\n", "", ErrInvalidProxyHeader},
+		{"V1BadAddress", "PROXY TCP4 192.0.2.256 192.0.2.2 56324 25565\r\n", "", ErrInvalidProxyHeader},
+		{"V1IPv6AsTCP4", "PROXY TCP4 2001:db8::1 2001:db8::2 56324 25565\r\n", "", ErrInvalidProxyHeader},
+		{"V1IPv4AsTCP6", "PROXY TCP6 192.0.2.1 192.0.2.2 56324 25565\r\n", "", ErrInvalidProxyHeader},
+		{"V1BadPort", "PROXY TCP4 192.0.2.1 192.0.2.2 65536 25565\r\n", "", ErrInvalidProxyHeader},
+		{"V1TooLong", "PROXY TCP4 " + strings.Repeat("1", 200) + "\r\n", "", ErrInvalidProxyHeader},
+		{"V1NoCRLF", "PROXY TCP4 192.0.2.1 192.0.2.2 56324 25565\n", "", io.EOF},
+		{"V2BadVersion", proxyV2(0x11, 0x11, proxyV2IPv4("192.0.2.1", "192.0.2.2", 56324, 25565)), "", ErrInvalidProxyHeader},
+		{"V2BadCommand", proxyV2(0x22, 0x11, proxyV2IPv4("192.0.2.1", "192.0.2.2", 56324, 25565)), "", ErrInvalidProxyHeader},
+		{"V2ShortIPv4", proxyV2(0x21, 0x11, make([]byte, 8)), "", ErrInvalidProxyHeader},
+		{"V2ShortIPv6", proxyV2(0x21, 0x21, make([]byte, 20)), "", ErrInvalidProxyHeader},
+		{"V2Truncated", proxyV2(0x21, 0x11, proxyV2IPv4("192.0.2.1", "192.0.2.2", 56324, 25565))[:20], "", io.ErrUnexpectedEOF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, client := net.Pipe()
+			defer server.Close()
+			// Whatever follows the header must be left for the connection
+			const after = "\x10\x00"
+			go func() {
+				client.Write([]byte(tt.header + after))
+				if tt.err != nil {
+					client.Close()
+				}
+			}()
+			defer client.Close()
+
+			conn, err := readProxyHeader(server)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("got error %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want == "" {
+				if conn != server {
+					t.Errorf("got remote address %v, want the connection's own", conn.RemoteAddr())
+				}
+			} else if got := conn.RemoteAddr().String(); got != tt.want {
+				t.Errorf("got remote address %s, want %s", got, tt.want)
+			}
+
+			rest := make([]byte, len(after))
+			if _, err := io.ReadFull(conn, rest); err != nil {
+				t.Fatal(err)
+			}
+			if string(rest) != after {
+				t.Errorf("got %q after the header, want %q", rest, after)
+			}
+		})
+	}
+}
+
+func TestListenerTrustedProxies(t *testing.T) {
+	const header = "PROXY TCP4 192.0.2.1 192.0.2.2 56324 25565\r\n"
+	tests := []struct {
+		name    string
+		trusted string
+		want    string
+	}{
+		{"Trusted", "127.0.0.0/8", "192.0.2.1"},
+		// An untrusted connection's header is left to be read as its first
+		// packet, so no one else can pass off an address
+		{"Untrusted", "10.0.0.0/8", "127.0.0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewListener("127.0.0.1", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			l.TrustedProxies = []netip.Prefix{netip.MustParsePrefix(tt.trusted)}
+			go l.Listen()
+			defer l.Close()
+
+			client, err := net.Dial("tcp", l.Addr().String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+			if _, err := client.Write([]byte(header)); err != nil {
+				t.Fatal(err)
+			}
+
+			select {
+			case conn := <-l.Incoming:
+				defer conn.Close()
+				if got := AddrIP(conn.RemoteAddr()).String(); got != tt.want {
+					t.Errorf("got remote address %s, want %s", got, tt.want)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatal("timed out waiting for the connection")
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package rcon
+
+import (
+	"bufio"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/brenfwd/gocraft/command"
+	"github.com/brenfwd/gocraft/data"
+)
+
+// Source RCON, which vanilla servers speak for remote administration.
+// See https://developer.valvesoftware.com/wiki/Source_RCON_Protocol
+
+const (
+	typeResponse = 0
+	typeCommand  = 2
+	typeAuthOK   = 2
+	typeLogin    = 3
+)
+
+const (
+	// Largest request vanilla accepts: the request ID, type, payload and two
+	// null bytes
+	maxRequestLength = 1460
+	// Responses longer than this are split into several packets
+	maxResponsePayload = 4096
+	// Time clients have to log in after connecting
+	loginTimeout = 10 * time.Second
+)
+
+var errBadPacket = errors.New("malformed RCON packet")
+
+type packet struct {
+	id      int32
+	typ     int32
+	payload string
+}
+
+// Accepts RCON clients and runs their commands as the console would.
+type Server struct {
+	password   string
+	dispatcher *command.Dispatcher
+	listener   net.Listener
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+func Listen(address string, password string, dispatcher *command.Dispatcher) (*Server, error) {
+	if password == "" {
+		return nil, errors.New("an RCON password is required")
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		password:   password,
+		dispatcher: dispatcher,
+		listener:   listener,
+		conns:      make(map[net.Conn]struct{}),
+	}, nil
+}
+
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Accepts clients until Close is called.
+func (s *Server) Serve() {
+	log.Println("RCON is listening on", s.listener.Addr())
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				log.Println("Error accepting RCON connection:", err)
+			}
+			return
+		}
+		s.mu.Lock()
+		s.conns[conn] = struct{}{}
+		s.mu.Unlock()
+		go func() {
+			defer func() {
+				s.mu.Lock()
+				delete(s.conns, conn)
+				s.mu.Unlock()
+				conn.Close()
+			}()
+			if err := s.handle(conn); err != nil && !errors.Is(err, io.EOF) {
+				log.Printf("RCON connection from %s: %v", conn.RemoteAddr(), err)
+			}
+		}()
+	}
+}
+
+// Stops accepting clients and disconnects the current ones.
+func (s *Server) Close() error {
+	err := s.listener.Close()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	return err
+}
+
+func (s *Server) handle(conn net.Conn) error {
+	r := bufio.NewReader(conn)
+
+	conn.SetReadDeadline(time.Now().Add(loginTimeout))
+	login, err := readPacket(r)
+	if err != nil {
+		return err
+	}
+	if login.typ != typeLogin || subtle.ConstantTimeCompare([]byte(login.payload), []byte(s.password)) != 1 {
+		log.Printf("RCON client %s failed to log in", conn.RemoteAddr())
+		return writePacket(conn, packet{id: -1, typ: typeAuthOK})
+	}
+	if err := writePacket(conn, packet{id: login.id, typ: typeAuthOK}); err != nil {
+		return err
+	}
+	conn.SetReadDeadline(time.Time{})
+	log.Printf("RCON client %s logged in", conn.RemoteAddr())
+
+	for {
+		req, err := readPacket(r)
+		if err != nil {
+			return err
+		}
+		if req.typ != typeCommand {
+			return fmt.Errorf("unexpected packet type %d", req.typ)
+		}
+		sender := &sender{}
+		s.dispatcher.Execute(sender, req.payload)
+		output := sender.output.String()
+		for {
+			chunk := output[:min(len(output), maxResponsePayload)]
+			if err := writePacket(conn, packet{id: req.id, typ: typeResponse, payload: chunk}); err != nil {
+				return err
+			}
+			output = output[len(chunk):]
+			if output == "" {
+				break
+			}
+		}
+	}
+}
+
+func readPacket(r io.Reader) (packet, error) {
+	var length int32
+	if err := binary.Read(r, binary.LittleEndian, &length); err != nil {
+		return packet{}, err
+	}
+	if length < 10 || length > maxRequestLength {
+		return packet{}, errBadPacket
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return packet{}, err
+	}
+	if body[length-1] != 0 || body[length-2] != 0 {
+		return packet{}, errBadPacket
+	}
+	return packet{
+		id:      int32(binary.LittleEndian.Uint32(body[0:])),
+		typ:     int32(binary.LittleEndian.Uint32(body[4:])),
+		payload: string(body[8 : length-2]),
+	}, nil
+}
+
+func writePacket(w io.Writer, p packet) error {
+	buf := make([]byte, 12, 14+len(p.payload))
+	binary.LittleEndian.PutUint32(buf[0:], uint32(10+len(p.payload)))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(p.id))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(p.typ))
+	buf = append(buf, p.payload...)
+	buf = append(buf, 0, 0)
+	_, err := w.Write(buf)
+	return err
+}
+
+// Collects the output of a command for the response.
+type sender struct {
+	output strings.Builder
+}
+
+func (s *sender) Name() string {
+	return "Rcon"
+}
+
+func (s *sender) SendMessage(msg *data.Chat) {
+	s.output.WriteString(msg.PlainText())
+	s.output.WriteString("\n")
+}
+
+// RCON clients have every permission, like the console.
+func (s *sender) Permission(node string) (bool, bool) {
+	return true, true
+}
+
+func (s *sender) OpLevel() int {
+	return 4
+}
@@ -0,0 +1,288 @@
+package network
+
+import (
+	"errors"
+	"log"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+)
+
+// Limits applied by a Listener to incoming connections. Zero values disable a
+// limit.
+type ThrottleConfig struct {
+	// New connections allowed per IP: a burst, refilled at a steady rate
+	ConnectionsPerSecond float64
+	ConnectionBurst      int
+	// Connections open at once per IP
+	MaxConnectionsPerIP int
+	// Time a connection has to finish logging in (or its status request)
+	// before it is closed
+	LoginTimeout time.Duration
+	// Packets a connection may send: a burst, refilled at a steady rate
+	PacketsPerSecond float64
+	PacketBurst      int
+	// How long IPs that exceed the connection or packet rate are banned
+	BanDuration time.Duration
+	// Addresses that are exempt from the per-IP limits, e.g. for load tests
+	Exempt []netip.Prefix
+}
+
+var DefaultThrottleConfig = ThrottleConfig{
+	ConnectionsPerSecond: 0.5,
+	ConnectionBurst:      5,
+	MaxConnectionsPerIP:  5,
+	LoginTimeout:         30 * time.Second,
+	PacketsPerSecond:     500,
+	PacketBurst:          1000,
+	BanDuration:          5 * time.Minute,
+	Exempt: []netip.Prefix{
+		netip.MustParsePrefix("127.0.0.0/8"),
+		netip.MustParsePrefix("::1/128"),
+	},
+}
+
+var (
+	ErrBanned             = errors.New("address is temporarily banned")
+	ErrConnectionRate     = errors.New("too many connections per second")
+	ErrTooManyConnections = errors.New("too many open connections")
+)
+
+// Counters of what a Throttler did, for metrics.
+type ThrottleStats struct {
+	Accepted           uint64
+	RejectedBanned     uint64
+	RejectedRate       uint64
+	RejectedConcurrent uint64
+	LoginTimeouts      uint64
+	PacketFloods       uint64
+	Bans               uint64
+}
+
+// Enforces a ThrottleConfig. Safe for concurrent use.
+type Throttler struct {
+	config ThrottleConfig
+
+	mu        sync.Mutex
+	ips       map[netip.Addr]*ipState
+	stats     ThrottleStats
+	reported  ThrottleStats
+	lastSweep time.Time
+}
+
+type ipState struct {
+	connections tokenBucket
+	open        int
+	bannedUntil time.Time
+}
+
+// How often stale per-IP state is dropped and the stats are logged.
+const throttleSweepInterval = time.Minute
+
+func NewThrottler(config ThrottleConfig) *Throttler {
+	return &Throttler{
+		config:    config,
+		ips:       make(map[netip.Addr]*ipState),
+		lastSweep: time.Now(),
+	}
+}
+
+func (t *Throttler) Stats() ThrottleStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.stats
+}
+
+// Bans an address for the given duration, rejecting its new connections.
+// Connections that are already open are left alone.
+func (t *Throttler) Ban(addr netip.Addr, duration time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.ban(addr, duration)
+}
+
+func (t *Throttler) Unban(addr netip.Addr) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if state, ok := t.ips[addr]; ok {
+		state.bannedUntil = time.Time{}
+	}
+}
+
+// Addresses currently banned, with the time their ban ends.
+func (t *Throttler) Bans() map[netip.Addr]time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	bans := make(map[netip.Addr]time.Time)
+	for addr, state := range t.ips {
+		if state.bannedUntil.After(now) {
+			bans[addr] = state.bannedUntil
+		}
+	}
+	return bans
+}
+
+// Must be called with mu held.
+func (t *Throttler) ban(addr netip.Addr, duration time.Duration) {
+	if duration <= 0 {
+		return
+	}
+	t.state(addr).bannedUntil = time.Now().Add(duration)
+	t.stats.Bans++
+	log.Printf("Banned %v for %v", addr, duration)
+}
+
+// Must be called with mu held.
+func (t *Throttler) state(addr netip.Addr) *ipState {
+	state, ok := t.ips[addr]
+	if !ok {
+		state = &ipState{connections: newTokenBucket(t.config.ConnectionsPerSecond, t.config.ConnectionBurst)}
+		t.ips[addr] = state
+	}
+	return state
+}
+
+func (t *Throttler) exempt(addr netip.Addr) bool {
+	for _, prefix := range t.config.Exempt {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Decides whether to accept a new connection from addr. Accepted connections
+// have to be attached, which releases them once they close.
+func (t *Throttler) admit(addr netip.Addr) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	t.sweep(now)
+
+	if t.exempt(addr) {
+		t.stats.Accepted++
+		return nil
+	}
+	state := t.state(addr)
+	if state.bannedUntil.After(now) {
+		t.stats.RejectedBanned++
+		return ErrBanned
+	}
+	if t.config.ConnectionsPerSecond > 0 && !state.connections.take(now) {
+		t.stats.RejectedRate++
+		t.ban(addr, t.config.BanDuration)
+		return ErrConnectionRate
+	}
+	if t.config.MaxConnectionsPerIP > 0 && state.open >= t.config.MaxConnectionsPerIP {
+		t.stats.RejectedConcurrent++
+		return ErrTooManyConnections
+	}
+	state.open++
+	t.stats.Accepted++
+	return nil
+}
+
+// Applies the per-connection limits to an accepted connection.
+func (t *Throttler) attach(conn *Connection, addr netip.Addr) {
+	if t.config.PacketsPerSecond > 0 {
+		limit := newTokenBucket(t.config.PacketsPerSecond, t.config.PacketBurst)
+		conn.packetLimit = &limit
+		conn.onFlood = func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			t.stats.PacketFloods++
+			if !t.exempt(addr) {
+				t.ban(addr, t.config.BanDuration)
+			}
+		}
+	}
+	if t.config.LoginTimeout > 0 {
+		conn.loginTimer = time.AfterFunc(t.config.LoginTimeout, func() {
+			log.Println("Login timed out for", conn.RemoteAddr())
+			t.mu.Lock()
+			t.stats.LoginTimeouts++
+			t.mu.Unlock()
+			conn.Close()
+		})
+	}
+	if !t.exempt(addr) {
+		conn.onClose = func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			if state, ok := t.ips[addr]; ok && state.open > 0 {
+				state.open--
+			}
+		}
+	}
+}
+
+// Drops the state of addresses without connections, bans or recent
+// connection attempts, and logs the stats if anything was rejected since the
+// last time. Must be called with mu held.
+func (t *Throttler) sweep(now time.Time) {
+	if now.Sub(t.lastSweep) < throttleSweepInterval {
+		return
+	}
+	t.lastSweep = now
+	for addr, state := range t.ips {
+		if state.open == 0 && !state.bannedUntil.After(now) && state.connections.full(now) {
+			delete(t.ips, addr)
+		}
+	}
+
+	s, r := t.stats, t.reported
+	if s.RejectedBanned != r.RejectedBanned || s.RejectedRate != r.RejectedRate || s.RejectedConcurrent != r.RejectedConcurrent ||
+		s.LoginTimeouts != r.LoginTimeouts || s.PacketFloods != r.PacketFloods {
+		log.Printf("Throttling: %d accepted, rejected %d banned, %d over rate, %d over concurrent limit; %d login timeouts, %d packet floods, %d bans",
+			s.Accepted-r.Accepted, s.RejectedBanned-r.RejectedBanned, s.RejectedRate-r.RejectedRate, s.RejectedConcurrent-r.RejectedConcurrent,
+			s.LoginTimeouts-r.LoginTimeouts, s.PacketFloods-r.PacketFloods, s.Bans-r.Bans)
+	}
+	t.reported = s
+}
+
+// The IP of a remote address, without any IPv4-in-IPv6 mapping.
+func AddrIP(addr net.Addr) netip.Addr {
+	if tcp, ok := addr.(*net.TCPAddr); ok {
+		return tcp.AddrPort().Addr().Unmap()
+	}
+	addrPort, err := netip.ParseAddrPort(addr.String())
+	if err != nil {
+		return netip.Addr{}
+	}
+	return addrPort.Addr().Unmap()
+}
+
+// Allows burst events at once, refilled at rate per second.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) tokenBucket {
+	return tokenBucket{rate: rate, burst: float64(max(burst, 1)), tokens: float64(max(burst, 1))}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if !b.last.IsZero() {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+}
+
+func (b *tokenBucket) take(now time.Time) bool {
+	b.refill(now)
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (b *tokenBucket) full(now time.Time) bool {
+	b.refill(now)
+	return b.tokens >= b.burst
+}
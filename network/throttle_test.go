@@ -0,0 +1,180 @@
+package network
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+var testAddr = netip.MustParseAddr("203.0.113.7")
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(2, 3)
+	for i := range 3 {
+		if !b.take(now) {
+			t.Fatalf("take %d of the burst failed", i)
+		}
+	}
+	if b.take(now) {
+		t.Fatal("took more than the burst")
+	}
+	// Two tokens a second
+	now = now.Add(500 * time.Millisecond)
+	if !b.take(now) {
+		t.Fatal("no token after refilling one")
+	}
+	if b.take(now) {
+		t.Fatal("took a token that wasn't refilled yet")
+	}
+	if b.full(now) {
+		t.Fatal("full right after taking")
+	}
+	// Never more than the burst, however long it waits
+	now = now.Add(time.Hour)
+	if !b.full(now) || b.tokens != 3 {
+		t.Fatalf("got %v tokens after an hour, want 3", b.tokens)
+	}
+}
+
+func TestThrottlerConnectionRate(t *testing.T) {
+	th := NewThrottler(ThrottleConfig{ConnectionsPerSecond: 0.001, ConnectionBurst: 2, BanDuration: time.Hour})
+	for i := range 2 {
+		if err := th.admit(testAddr); err != nil {
+			t.Fatalf("connection %d: %v", i, err)
+		}
+	}
+	if err := th.admit(testAddr); !errors.Is(err, ErrConnectionRate) {
+		t.Fatalf("got %v over the rate, want ErrConnectionRate", err)
+	}
+	// Going over the rate bans the address
+	if err := th.admit(testAddr); !errors.Is(err, ErrBanned) {
+		t.Fatalf("got %v after the ban, want ErrBanned", err)
+	}
+	if _, ok := th.Bans()[testAddr]; !ok {
+		t.Error("the address isn't listed as banned")
+	}
+	// Other addresses aren't affected
+	if err := th.admit(netip.MustParseAddr("203.0.113.8")); err != nil {
+		t.Errorf("another address: %v", err)
+	}
+
+	stats := th.Stats()
+	want := ThrottleStats{Accepted: 3, RejectedRate: 1, RejectedBanned: 1, Bans: 1}
+	if stats != want {
+		t.Errorf("got stats %+v, want %+v", stats, want)
+	}
+}
+
+func TestThrottlerBanExpiry(t *testing.T) {
+	th := NewThrottler(ThrottleConfig{})
+	th.Ban(testAddr, 50*time.Millisecond)
+	if err := th.admit(testAddr); !errors.Is(err, ErrBanned) {
+		t.Fatalf("got %v while banned, want ErrBanned", err)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if err := th.admit(testAddr); err != nil {
+		t.Fatalf("got %v after the ban ended", err)
+	}
+	if len(th.Bans()) != 0 {
+		t.Errorf("got bans %v after the ban ended", th.Bans())
+	}
+
+	th.Ban(testAddr, time.Hour)
+	th.Unban(testAddr)
+	if err := th.admit(testAddr); err != nil {
+		t.Errorf("got %v after unbanning", err)
+	}
+
+	// A ban of no duration does nothing
+	th.Ban(testAddr, 0)
+	if err := th.admit(testAddr); err != nil {
+		t.Errorf("got %v after a zero ban", err)
+	}
+}
+
+func TestThrottlerMaxConnections(t *testing.T) {
+	th := NewThrottler(ThrottleConfig{MaxConnectionsPerIP: 1})
+	if err := th.admit(testAddr); err != nil {
+		t.Fatal(err)
+	}
+	server, client := net.Pipe()
+	defer client.Close()
+	conn := NewConnection(server, nil)
+	th.attach(conn, testAddr)
+
+	if err := th.admit(testAddr); !errors.Is(err, ErrTooManyConnections) {
+		t.Fatalf("got %v over the limit, want ErrTooManyConnections", err)
+	}
+	// Closing the connection frees its slot
+	conn.Close()
+	if err := th.admit(testAddr); err != nil {
+		t.Errorf("got %v after the connection closed", err)
+	}
+}
+
+func TestThrottlerExempt(t *testing.T) {
+	th := NewThrottler(ThrottleConfig{
+		ConnectionsPerSecond: 0.001,
+		ConnectionBurst:      1,
+		MaxConnectionsPerIP:  1,
+		BanDuration:          time.Hour,
+		Exempt:               []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+	})
+	for i := range 10 {
+		if err := th.admit(netip.MustParseAddr("10.1.2.3")); err != nil {
+			t.Fatalf("connection %d: %v", i, err)
+		}
+	}
+	if len(th.ips) != 0 {
+		t.Errorf("kept state for an exempt address")
+	}
+}
+
+func TestThrottlerSweep(t *testing.T) {
+	th := NewThrottler(ThrottleConfig{ConnectionsPerSecond: 1000, ConnectionBurst: 1})
+	banned := netip.MustParseAddr("203.0.113.8")
+	if err := th.admit(testAddr); err != nil {
+		t.Fatal(err)
+	}
+	th.Ban(banned, time.Hour)
+
+	// testAddr still has its connection open
+	th.mu.Lock()
+	th.sweep(time.Now().Add(throttleSweepInterval))
+	th.mu.Unlock()
+	if len(th.ips) != 2 {
+		t.Fatalf("got state for %d addresses, want 2", len(th.ips))
+	}
+
+	th.ips[testAddr].open = 0
+	th.mu.Lock()
+	th.sweep(time.Now().Add(2 * throttleSweepInterval))
+	th.mu.Unlock()
+	if _, ok := th.ips[testAddr]; ok {
+		t.Error("kept the state of an idle address")
+	}
+	if _, ok := th.ips[banned]; !ok {
+		t.Error("dropped the state of a banned address")
+	}
+}
+
+func TestAddrIP(t *testing.T) {
+	tests := []struct {
+		addr net.Addr
+		want netip.Addr
+	}{
+		{&net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 25565}, netip.MustParseAddr("192.0.2.1")},
+		{&net.TCPAddr{IP: net.ParseIP("::ffff:192.0.2.1"), Port: 25565}, netip.MustParseAddr("192.0.2.1")},
+		{&net.TCPAddr{IP: net.ParseIP("2001:db8::1"), Port: 25565}, netip.MustParseAddr("2001:db8::1")},
+		{&net.UDPAddr{IP: net.ParseIP("192.0.2.1"), Port: 25565}, netip.MustParseAddr("192.0.2.1")},
+		{&net.UnixAddr{Name: "/tmp/socket", Net: "unix"}, netip.Addr{}},
+	}
+	for _, tt := range tests {
+		if got := AddrIP(tt.addr); got != tt.want {
+			t.Errorf("AddrIP(%v) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
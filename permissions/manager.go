@@ -0,0 +1,305 @@
+package permissions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/brenfwd/gocraft/atomicfile"
+	"github.com/google/uuid"
+)
+
+// The group every player is in, after the ones they were added to.
+const DefaultGroup = "default"
+
+type Group struct {
+	// Groups whose permissions apply unless this one sets the same node
+	Inherits    []string `json:"inherits,omitempty"`
+	Permissions Set      `json:"permissions"`
+}
+
+type Player struct {
+	// Last known name, for whoever edits the file
+	Name        string   `json:"name"`
+	Groups      []string `json:"groups,omitempty"`
+	Permissions Set      `json:"permissions,omitempty"`
+}
+
+type file struct {
+	Groups  map[string]*Group     `json:"groups"`
+	Players map[uuid.UUID]*Player `json:"players"`
+}
+
+// The groups and per-player permissions, stored in a JSON file. A player's
+// own permissions override those of their groups, and a group's override
+// those it inherits. Safe for concurrent use; every change is saved right
+// away.
+type Manager struct {
+	path string
+
+	mu   sync.RWMutex
+	file file
+}
+
+// Loads the permissions from path, creating the file with an empty default
+// group if it doesn't exist.
+func Open(path string) (*Manager, error) {
+	m := &Manager{path: path}
+	if err := m.Reload(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *Manager) Reload() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	raw, err := os.ReadFile(m.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		m.file = file{
+			Groups:  map[string]*Group{DefaultGroup: {Permissions: Set{}}},
+			Players: make(map[uuid.UUID]*Player),
+		}
+		return m.save()
+	}
+	if err != nil {
+		return err
+	}
+	var f file
+	if err := json.Unmarshal(raw, &f); err != nil {
+		return fmt.Errorf("%s: %w", m.path, err)
+	}
+	if f.Groups == nil {
+		f.Groups = make(map[string]*Group)
+	}
+	if f.Players == nil {
+		f.Players = make(map[uuid.UUID]*Player)
+	}
+	m.file = f
+	return nil
+}
+
+// The value of node for the player: from their own permissions, then their
+// groups in order (each before the groups it inherits) and finally the default
+// group.
+func (m *Manager) Permission(id uuid.UUID, node string) (value bool, ok bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var groups []string
+	if player, found := m.file.Players[id]; found {
+		if value, ok := player.Permissions.Lookup(node); ok {
+			return value, true
+		}
+		groups = player.Groups
+	}
+	visited := make(map[string]bool)
+	for _, group := range append(slices.Clone(groups), DefaultGroup) {
+		if value, ok := m.groupPermission(group, node, visited); ok {
+			return value, true
+		}
+	}
+	return false, false
+}
+
+// Looks node up in a group and then the groups it inherits, skipping the ones
+// already visited (so inheritance cycles end). Must be called with mu held.
+func (m *Manager) groupPermission(name string, node string, visited map[string]bool) (bool, bool) {
+	if visited[name] {
+		return false, false
+	}
+	visited[name] = true
+	group, ok := m.file.Groups[name]
+	if !ok {
+		return false, false
+	}
+	if value, ok := group.Permissions.Lookup(node); ok {
+		return value, true
+	}
+	for _, parent := range group.Inherits {
+		if value, ok := m.groupPermission(parent, node, visited); ok {
+			return value, true
+		}
+	}
+	return false, false
+}
+
+// The names of the groups, sorted.
+func (m *Manager) Groups() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	names := make([]string, 0, len(m.file.Groups))
+	for name := range m.file.Groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (m *Manager) Group(name string) (Group, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	group, ok := m.file.Groups[name]
+	if !ok {
+		return Group{}, false
+	}
+	return Group{Inherits: slices.Clone(group.Inherits), Permissions: maps.Clone(group.Permissions)}, true
+}
+
+func (m *Manager) Player(id uuid.UUID) (Player, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	player, ok := m.file.Players[id]
+	if !ok {
+		return Player{}, false
+	}
+	return Player{Name: player.Name, Groups: slices.Clone(player.Groups), Permissions: maps.Clone(player.Permissions)}, true
+}
+
+// Sets a node for a group, creating the group if needed.
+func (m *Manager) SetGroupPermission(group string, node string, value bool) error {
+	if !ValidNode(node) {
+		return fmt.Errorf("invalid permission node %q", node)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	g, ok := m.file.Groups[group]
+	if !ok {
+		g = &Group{Permissions: Set{}}
+		m.file.Groups[group] = g
+	}
+	if g.Permissions == nil {
+		g.Permissions = Set{}
+	}
+	g.Permissions[node] = value
+	return m.save()
+}
+
+// Removes a node from a group, reporting whether it was set.
+func (m *Manager) UnsetGroupPermission(group string, node string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	g, ok := m.file.Groups[group]
+	if !ok {
+		return false, nil
+	}
+	if _, ok := g.Permissions[node]; !ok {
+		return false, nil
+	}
+	delete(g.Permissions, node)
+	return true, m.save()
+}
+
+// Replaces the groups a group inherits, which have to exist.
+func (m *Manager) SetGroupInherits(group string, parents []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	g, ok := m.file.Groups[group]
+	if !ok {
+		return fmt.Errorf("no group named %q", group)
+	}
+	for _, parent := range parents {
+		if _, ok := m.file.Groups[parent]; !ok {
+			return fmt.Errorf("no group named %q", parent)
+		}
+	}
+	g.Inherits = parents
+	return m.save()
+}
+
+// Sets a node for a player; name is stored for reference.
+func (m *Manager) SetPlayerPermission(id uuid.UUID, name string, node string, value bool) error {
+	if !ValidNode(node) {
+		return fmt.Errorf("invalid permission node %q", node)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	player := m.player(id, name)
+	if player.Permissions == nil {
+		player.Permissions = Set{}
+	}
+	player.Permissions[node] = value
+	return m.save()
+}
+
+// Removes a node from a player, reporting whether it was set.
+func (m *Manager) UnsetPlayerPermission(id uuid.UUID, node string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	player, ok := m.file.Players[id]
+	if !ok {
+		return false, nil
+	}
+	if _, ok := player.Permissions[node]; !ok {
+		return false, nil
+	}
+	delete(player.Permissions, node)
+	m.prune(id)
+	return true, m.save()
+}
+
+// Adds a player to a group, which has to exist.
+func (m *Manager) AddPlayerGroup(id uuid.UUID, name string, group string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.file.Groups[group]; !ok {
+		return fmt.Errorf("no group named %q", group)
+	}
+	player := m.player(id, name)
+	if !slices.Contains(player.Groups, group) {
+		player.Groups = append(player.Groups, group)
+	}
+	return m.save()
+}
+
+// Removes a player from a group, reporting whether they were in it.
+func (m *Manager) RemovePlayerGroup(id uuid.UUID, group string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	player, ok := m.file.Players[id]
+	if !ok {
+		return false, nil
+	}
+	i := slices.Index(player.Groups, group)
+	if i < 0 {
+		return false, nil
+	}
+	player.Groups = slices.Delete(player.Groups, i, i+1)
+	m.prune(id)
+	return true, m.save()
+}
+
+// The player's entry, created if needed. Must be called with mu held.
+func (m *Manager) player(id uuid.UUID, name string) *Player {
+	player, ok := m.file.Players[id]
+	if !ok {
+		player = &Player{}
+		m.file.Players[id] = player
+	}
+	player.Name = name
+	return player
+}
+
+// Drops the player's entry if nothing is set for them anymore. Must be called
+// with mu held.
+func (m *Manager) prune(id uuid.UUID) {
+	if player := m.file.Players[id]; len(player.Groups) == 0 && len(player.Permissions) == 0 {
+		delete(m.file.Players, id)
+	}
+}
+
+// Must be called with mu held.
+func (m *Manager) save() error {
+	raw, err := json.MarshalIndent(m.file, "", "  ")
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(m.path, append(raw, '\n'), 0o644)
+}
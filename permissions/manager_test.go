@@ -0,0 +1,170 @@
+package permissions
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+var (
+	notch = uuid.MustParse("069a79f4-44e9-4726-a5be-fca90e38aaf5")
+	jeb   = uuid.MustParse("853c80ef-3c37-49fd-aa49-938b674adae6")
+)
+
+func open(t *testing.T, content string) *Manager {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "permissions.json")
+	if content != "" {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestPermissionLookupOrder(t *testing.T) {
+	m := open(t, `{
+  "groups": {
+    "default": {"permissions": {"gocraft.command.*": false, "gocraft.chat": true}},
+    "builder": {"inherits": ["member"], "permissions": {"gocraft.build": true, "gocraft.fly": false}},
+    "member": {"permissions": {"gocraft.build": false, "gocraft.home": true, "gocraft.fly": true}},
+    "moderator": {"inherits": ["member"], "permissions": {"gocraft.command.*": true, "gocraft.fly": true}},
+    "loop-a": {"inherits": ["loop-b"], "permissions": {}},
+    "loop-b": {"inherits": ["loop-a"], "permissions": {"gocraft.loop": true}}
+  },
+  "players": {
+    "069a79f4-44e9-4726-a5be-fca90e38aaf5": {
+      "name": "Notch",
+      "groups": ["builder", "moderator"],
+      "permissions": {"gocraft.command.stop": false}
+    },
+    "853c80ef-3c37-49fd-aa49-938b674adae6": {
+      "name": "jeb_",
+      "groups": ["loop-a", "missing"]
+    }
+  }
+}`)
+	tests := []struct {
+		name  string
+		id    uuid.UUID
+		node  string
+		value bool
+		ok    bool
+	}{
+		// The player's own permissions come first
+		{"PlayerOverridesGroups", notch, "gocraft.command.stop", false, true},
+		// Then the groups in order, so builder's before moderator's
+		{"FirstGroupWins", notch, "gocraft.fly", false, true},
+		{"LaterGroup", notch, "gocraft.command.kick", true, true},
+		// A group's own nodes before the ones it inherits
+		{"GroupOverridesInherited", notch, "gocraft.build", true, true},
+		{"Inherited", notch, "gocraft.home", true, true},
+		// The default group last
+		{"Default", notch, "gocraft.chat", true, true},
+		{"DefaultForUnknownPlayer", uuid.New(), "gocraft.command.kick", false, true},
+		{"Unset", notch, "gocraft.other", false, false},
+		// Inheritance cycles and missing groups end the lookup
+		{"Cycle", jeb, "gocraft.loop", true, true},
+		{"CycleUnset", jeb, "gocraft.other", false, false},
+	}
+	for _, tt := range tests {
+		value, ok := m.Permission(tt.id, tt.node)
+		if value != tt.value || ok != tt.ok {
+			t.Errorf("%s: Permission(%q) = %v, %v, want %v, %v", tt.name, tt.node, value, ok, tt.value, tt.ok)
+		}
+	}
+}
+
+func TestManagerChanges(t *testing.T) {
+	m := open(t, "")
+	if got := m.Groups(); !reflect.DeepEqual(got, []string{DefaultGroup}) {
+		t.Fatalf("got groups %v in a new file, want only the default one", got)
+	}
+
+	if err := m.SetGroupPermission("member", "gocraft.home", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.SetGroupInherits(DefaultGroup, []string{"member"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.AddPlayerGroup(notch, "Notch", "member"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.SetPlayerPermission(notch, "Notch", "gocraft.fly", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.SetPlayerPermission(jeb, "jeb_", "*", true); err != nil {
+		t.Fatal(err)
+	}
+
+	// Saved right away, so opening the file again gives the same
+	reopened, err := Open(m.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reopened.Groups(); !reflect.DeepEqual(got, []string{DefaultGroup, "member"}) {
+		t.Errorf("got groups %v", got)
+	}
+	if group, _ := reopened.Group(DefaultGroup); !reflect.DeepEqual(group.Inherits, []string{"member"}) {
+		t.Errorf("default group inherits %v, want member", group.Inherits)
+	}
+	want := Player{Name: "Notch", Groups: []string{"member"}, Permissions: Set{"gocraft.fly": true}}
+	if got, ok := reopened.Player(notch); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("got player %+v, want %+v", got, want)
+	}
+	if value, ok := reopened.Permission(jeb, "gocraft.home"); !value || !ok {
+		t.Error("jeb_'s wildcard wasn't saved")
+	}
+
+	// A player with nothing set is dropped
+	if removed, err := m.RemovePlayerGroup(notch, "member"); !removed || err != nil {
+		t.Fatalf("RemovePlayerGroup = %v, %v", removed, err)
+	}
+	if removed, err := m.UnsetPlayerPermission(notch, "gocraft.fly"); !removed || err != nil {
+		t.Fatalf("UnsetPlayerPermission = %v, %v", removed, err)
+	}
+	if _, ok := m.Player(notch); ok {
+		t.Error("kept a player with nothing set")
+	}
+	if removed, _ := m.UnsetPlayerPermission(notch, "gocraft.fly"); removed {
+		t.Error("unset a node twice")
+	}
+	if removed, err := m.UnsetGroupPermission("member", "gocraft.home"); !removed || err != nil {
+		t.Fatalf("UnsetGroupPermission = %v, %v", removed, err)
+	}
+	if _, ok := m.Permission(notch, "gocraft.home"); ok {
+		t.Error("the unset node still applies")
+	}
+}
+
+func TestManagerErrors(t *testing.T) {
+	m := open(t, "")
+	if err := m.SetGroupPermission(DefaultGroup, "Not A Node", true); err == nil {
+		t.Error("set an invalid node for a group")
+	}
+	if err := m.SetPlayerPermission(notch, "Notch", "gocraft.*.kick", true); err == nil {
+		t.Error("set an invalid node for a player")
+	}
+	if err := m.AddPlayerGroup(notch, "Notch", "missing"); err == nil {
+		t.Error("added a player to a missing group")
+	}
+	if err := m.SetGroupInherits(DefaultGroup, []string{"missing"}); err == nil {
+		t.Error("inherited a missing group")
+	}
+	if err := m.SetGroupInherits("missing", nil); err == nil {
+		t.Error("set the parents of a missing group")
+	}
+
+	path := filepath.Join(t.TempDir(), "permissions.json")
+	os.WriteFile(path, []byte("{"), 0o644)
+	if _, err := Open(path); err == nil {
+		t.Error("opened a broken file")
+	}
+}
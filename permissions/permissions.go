@@ -0,0 +1,68 @@
+package permissions
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Something whose permissions can be checked, i.e. a player or the console.
+// Permissions are dot-separated nodes like "gocraft.command.kick", which can
+// be set for several at once with wildcards like "gocraft.command.*" or "*".
+type Permissible interface {
+	// The value the node was set to for the permissible (directly, through a
+	// wildcard or through one of its groups), if it was set at all
+	Permission(node string) (value bool, ok bool)
+	// Operator level from 0 to 4
+	OpLevel() int
+}
+
+// Whether p has the node: the value it was set to, or otherwise whether p is
+// an operator of at least level (which may be 0 to grant it to everyone).
+func Has(p Permissible, node string, level int) bool {
+	if value, ok := p.Permission(node); ok {
+		return value
+	}
+	return p.OpLevel() >= level
+}
+
+// Lowercase segments separated by dots, where the last may be a "*" wildcard.
+var nodeRegexp = regexp.MustCompile(`^(\*|[a-z0-9_-]+(\.[a-z0-9_-]+)*(\.\*)?)$`)
+
+func ValidNode(node string) bool {
+	return nodeRegexp.MatchString(node)
+}
+
+// Permission values by node or wildcard.
+type Set map[string]bool
+
+// The value of the most specific entry covering node: the node itself, then
+// the wildcards from the longest, e.g. "a.b.c", "a.b.*", "a.*" and "*".
+func (s Set) Lookup(node string) (value bool, ok bool) {
+	if value, ok := s[node]; ok {
+		return value, true
+	}
+	for prefix := node; ; {
+		i := strings.LastIndexByte(prefix, '.')
+		if i < 0 {
+			break
+		}
+		prefix = prefix[:i]
+		if value, ok := s[prefix+".*"]; ok {
+			return value, true
+		}
+	}
+	value, ok = s["*"]
+	return value, ok
+}
+
+// Formats a set as "node=value" pairs sorted by node, for commands.
+func (s Set) String() string {
+	nodes := make([]string, 0, len(s))
+	for node, value := range s {
+		nodes = append(nodes, fmt.Sprintf("%s=%t", node, value))
+	}
+	sort.Strings(nodes)
+	return strings.Join(nodes, ", ")
+}
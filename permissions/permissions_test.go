@@ -0,0 +1,103 @@
+package permissions
+
+import "testing"
+
+func TestSetLookup(t *testing.T) {
+	s := Set{
+		"gocraft.command.kick":   true,
+		"gocraft.command.*":      false,
+		"gocraft.command.ban.ip": false,
+		"gocraft.*":              true,
+		"*":                      false,
+	}
+	tests := []struct {
+		node  string
+		value bool
+		ok    bool
+	}{
+		{"gocraft.command.kick", true, true},
+		// The longest wildcard wins
+		{"gocraft.command.ban", false, true},
+		{"gocraft.command.ban.ip", false, true},
+		{"gocraft.command.ban.name", false, true},
+		{"gocraft.fly", true, true},
+		{"other.node", false, true},
+		// Wildcards only match whole segments
+		{"gocraftx.fly", false, true},
+	}
+	for _, tt := range tests {
+		value, ok := s.Lookup(tt.node)
+		if value != tt.value || ok != tt.ok {
+			t.Errorf("Lookup(%q) = %v, %v, want %v, %v", tt.node, value, ok, tt.value, tt.ok)
+		}
+	}
+
+	narrow := Set{"gocraft.command.*": true}
+	for _, node := range []string{"gocraft.command", "gocraft.fly", "other"} {
+		if _, ok := narrow.Lookup(node); ok {
+			t.Errorf("Lookup(%q) found a value, want none", node)
+		}
+	}
+	if _, ok := Set(nil).Lookup("gocraft.fly"); ok {
+		t.Error("a nil set found a value")
+	}
+}
+
+func TestValidNode(t *testing.T) {
+	tests := map[string]bool{
+		"*":                     true,
+		"gocraft":               true,
+		"gocraft.command.kick":  true,
+		"gocraft.command.*":     true,
+		"my-plugin.use_thing.2": true,
+		"":                      false,
+		"Gocraft.command":       false,
+		"gocraft..command":      false,
+		"gocraft.":              false,
+		".gocraft":              false,
+		"gocraft.*.kick":        false,
+		"gocraft*":              false,
+		"gocraft command":       false,
+	}
+	for node, want := range tests {
+		if got := ValidNode(node); got != want {
+			t.Errorf("ValidNode(%q) = %v, want %v", node, got, want)
+		}
+	}
+}
+
+type permissible struct {
+	set   Set
+	level int
+}
+
+func (p permissible) Permission(node string) (bool, bool) {
+	return p.set.Lookup(node)
+}
+
+func (p permissible) OpLevel() int {
+	return p.level
+}
+
+func TestHas(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     permissible
+		level int
+		want  bool
+	}{
+		{"Everyone", permissible{}, 0, true},
+		{"NotOp", permissible{}, 2, false},
+		{"Op", permissible{level: 2}, 2, true},
+		{"LowerOp", permissible{level: 1}, 2, false},
+		{"Granted", permissible{set: Set{"gocraft.command.kick": true}}, 4, true},
+		{"GrantedByWildcard", permissible{set: Set{"gocraft.*": true}}, 4, true},
+		// A node set to false overrides the op level
+		{"Denied", permissible{set: Set{"gocraft.command.kick": false}, level: 4}, 2, false},
+	}
+	for _, tt := range tests {
+		if got := Has(tt.p, "gocraft.command.kick", tt.level); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
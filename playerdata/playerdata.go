@@ -0,0 +1,304 @@
+package playerdata
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/brenfwd/gocraft/atomicfile"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/world"
+	"github.com/google/uuid"
+)
+
+const (
+	// The only dimension there is so far
+	Overworld = "minecraft:overworld"
+	// Data version written to the files, 1.21's. Block and item IDs are 1.21's
+	// whatever version the client speaks.
+	DataVersion = 3953
+)
+
+// Stores each player's position, stats, inventory and game mode in
+// <dir>/<uuid>.dat, in the format vanilla uses for its playerdata directory:
+// gzipped NBT. Entries gocraft doesn't use, e.g. from a file vanilla wrote,
+// are kept when saving. Safe for concurrent use.
+type Store struct {
+	dir string
+}
+
+// Opens the directory the player data is kept in, creating it if needed.
+func Open(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &Store{dir: dir}, nil
+}
+
+func (s *Store) path(id uuid.UUID) string {
+	return filepath.Join(s.dir, id.String()+".dat")
+}
+
+// Restores a player from their saved data, reporting whether there was any.
+// The position is only restored if it is in a loaded chunk of the overworld,
+// otherwise the player stays at the spawn.
+func (s *Store) Load(p *world.Player) (bool, error) {
+	root, err := s.read(p.UUID)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	apply(p, root)
+	return true, nil
+}
+
+// Writes the player's current state to their file.
+func (s *Store) Save(p *world.Player) error {
+	root, err := s.read(p.UUID)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		// Better to lose the unknown entries than to never save again
+		log.Printf("Replacing unreadable player data of %s: %v", p.Username, err)
+	}
+	if err != nil {
+		root = data.NBTCompoundValue(nil, nil)
+	}
+
+	buf := data.Buffer{}
+	if err := snapshot(p, root).WriteNamed(&buf); err != nil {
+		return err
+	}
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write(buf.Raw); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(s.path(p.UUID), compressed.Bytes(), 0o644)
+}
+
+// Saves every player given, logging failures. Sessions replaced by a new login
+// are skipped; they were saved then.
+func (s *Store) SaveAll(players []*world.Player) {
+	for _, p := range players {
+		if p.Replaced() {
+			continue
+		}
+		if err := s.Save(p); err != nil {
+			log.Printf("Error saving player data of %s: %v", p.Username, err)
+		}
+	}
+}
+
+func (s *Store) read(id uuid.UUID) (*data.NBTValue, error) {
+	f, err := os.Open(s.path(id))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", f.Name(), err)
+	}
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", f.Name(), err)
+	}
+	buf := data.NewBufferFromBytes(raw)
+	root, err := data.ReadNamedNBT(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", f.Name(), err)
+	}
+	if root.Tag != data.TAG_Compound {
+		return nil, fmt.Errorf("%s: root is a %v, not a compound", f.Name(), root.Tag)
+	}
+	return root, nil
+}
+
+// Copies the saved state onto the player. Missing or mistyped entries keep the
+// player's current value.
+func apply(p *world.Player, root *data.NBTValue) {
+	if dimension, _ := getValue[string](root, "Dimension"); dimension != "" && dimension != Overworld {
+		log.Printf("Player %s was saved in %s, which doesn't exist; moving them to the spawn", p.Username, dimension)
+	} else if pos, ok := getList[float64](root, "Pos", 3); ok {
+		pos := data.Vec3{X: pos[0], Y: pos[1], Z: pos[2]}
+		if pos.IsFinite() && p.World.IsChunkLoaded(world.ChunkPosAt(pos)) {
+			p.SetPosition(pos)
+			if rotation, ok := getList[float32](root, "Rotation", 2); ok && isFinite(rotation[0]) && isFinite(rotation[1]) {
+				p.SetRotation(rotation[0], rotation[1])
+			}
+		} else {
+			log.Printf("Player %s was saved at %v, which isn't loaded; moving them to the spawn", p.Username, pos)
+		}
+	}
+
+	if mode, ok := getValue[int32](root, "playerGameType"); ok && mode >= 0 && mode <= int32(world.GameModeSpectator) {
+		p.GameMode = world.GameMode(mode)
+	}
+
+	stats := p.Stats()
+	setFrom(root, "Health", &stats.Health)
+	setFrom(root, "foodLevel", &stats.FoodLevel)
+	setFrom(root, "foodSaturationLevel", &stats.FoodSaturation)
+	setFrom(root, "foodExhaustionLevel", &stats.FoodExhaustion)
+	setFrom(root, "XpLevel", &stats.XPLevel)
+	setFrom(root, "XpP", &stats.XPProgress)
+	setFrom(root, "XpTotal", &stats.XPTotal)
+	p.SetStats(stats)
+
+	if entries, ok := getValue[[]*data.NBTValue](root, "Inventory"); ok {
+		items := make([]world.InventoryItem, 0, len(entries))
+		for _, entry := range entries {
+			if item, ok := readItem(entry); ok {
+				items = append(items, item)
+			}
+		}
+		p.SetInventory(items)
+	}
+}
+
+// Reads an inventory entry, either as 1.20.5 and later save it (count and
+// components) or as earlier versions did (Count). Entries without an item are
+// dropped.
+func readItem(entry *data.NBTValue) (world.InventoryItem, bool) {
+	slot, okSlot := getValue[byte](entry, "Slot")
+	id, okID := getValue[string](entry, "id")
+	if !okSlot || !okID || id == "" {
+		return world.InventoryItem{}, false
+	}
+	item := world.InventoryItem{Slot: int8(slot), ID: id, Count: 1}
+	if count, ok := getValue[int32](entry, "count"); ok {
+		item.Count = count
+	} else if count, ok := getValue[byte](entry, "Count"); ok {
+		item.Count = int32(int8(count))
+	}
+	if item.Count <= 0 {
+		return world.InventoryItem{}, false
+	}
+	if components := entry.Get("components"); components != nil && components.Tag == data.TAG_Compound {
+		item.Components = components
+	}
+	return item, true
+}
+
+// The player's state as saved, merged into root.
+func snapshot(p *world.Player, root *data.NBTValue) *data.NBTValue {
+	pos := p.Position()
+	yaw, pitch := p.Rotation()
+	stats := p.Stats()
+
+	var inventory []*data.NBTValue
+	for _, item := range p.Inventory() {
+		entries := []*data.NBTValue{
+			data.NBTByteValue("Slot", byte(item.Slot)),
+			data.NBTStringValue("id", item.ID),
+			data.NBTIntValue("count", item.Count),
+		}
+		if item.Components != nil {
+			name := "components"
+			entries = append(entries, data.NBTCompoundValue(&name, item.Components.Value.([]*data.NBTValue)))
+		}
+		inventory = append(inventory, data.NBTCompoundValue(nil, entries))
+	}
+
+	// Vanilla stores UUIDs as four ints, most significant first
+	uuidInts := make([]int32, 4)
+	for i := range uuidInts {
+		uuidInts[i] = int32(binary.BigEndian.Uint32(p.UUID[4*i:]))
+	}
+
+	entries := []*data.NBTValue{
+		data.NBTIntValue("DataVersion", DataVersion),
+		data.NBTIntArrayValue("UUID", uuidInts),
+		data.NBTListValue("Pos", []*data.NBTValue{
+			data.NBTDoubleValue("", pos.X), data.NBTDoubleValue("", pos.Y), data.NBTDoubleValue("", pos.Z),
+		}),
+		data.NBTListValue("Rotation", []*data.NBTValue{data.NBTFloatValue("", yaw), data.NBTFloatValue("", pitch)}),
+		data.NBTByteValue("OnGround", boolByte(p.OnGround())),
+		data.NBTStringValue("Dimension", Overworld),
+		data.NBTIntValue("playerGameType", int32(p.GameMode)),
+		data.NBTFloatValue("Health", stats.Health),
+		data.NBTIntValue("foodLevel", stats.FoodLevel),
+		data.NBTFloatValue("foodSaturationLevel", stats.FoodSaturation),
+		data.NBTFloatValue("foodExhaustionLevel", stats.FoodExhaustion),
+		data.NBTIntValue("XpLevel", stats.XPLevel),
+		data.NBTFloatValue("XpP", stats.XPProgress),
+		data.NBTIntValue("XpTotal", stats.XPTotal),
+		data.NBTListValue("Inventory", inventory),
+	}
+
+	// Keep whatever else was in the file, in its original order
+	var merged []*data.NBTValue
+	replaced := make(map[string]bool)
+	for _, old := range root.Value.([]*data.NBTValue) {
+		for _, entry := range entries {
+			if old.Name != nil && *old.Name == *entry.Name {
+				old = entry
+				replaced[*entry.Name] = true
+				break
+			}
+		}
+		merged = append(merged, old)
+	}
+	for _, entry := range entries {
+		if !replaced[*entry.Name] {
+			merged = append(merged, entry)
+		}
+	}
+	return data.NBTCompoundValue(nil, merged)
+}
+
+func isFinite(f float32) bool {
+	return !math.IsNaN(float64(f)) && !math.IsInf(float64(f), 0)
+}
+
+func boolByte(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// The value of a compound entry, if it exists and has the type of T.
+func getValue[T any](compound *data.NBTValue, name string) (T, bool) {
+	var zero T
+	entry := compound.Get(name)
+	if entry == nil {
+		return zero, false
+	}
+	value, ok := entry.Value.(T)
+	return value, ok
+}
+
+// The values of a list entry of the given length whose elements have the
+// type of T.
+func getList[T any](compound *data.NBTValue, name string, length int) ([]T, bool) {
+	entries, ok := getValue[[]*data.NBTValue](compound, name)
+	if !ok || len(entries) != length {
+		return nil, false
+	}
+	values := make([]T, length)
+	for i, entry := range entries {
+		if values[i], ok = entry.Value.(T); !ok {
+			return nil, false
+		}
+	}
+	return values, true
+}
+
+func setFrom[T any](compound *data.NBTValue, name string, dst *T) {
+	if value, ok := getValue[T](compound, name); ok {
+		*dst = value
+	}
+}
@@ -0,0 +1,303 @@
+package playerdata
+
+import (
+	"bytes"
+	"compress/gzip"
+	"math"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/world"
+	"github.com/google/uuid"
+)
+
+var notch = uuid.MustParse("069a79f4-44e9-4726-a5be-fca90e38aaf5")
+
+func newPlayer(w *world.World) *world.Player {
+	return world.NewPlayer(w, nil, notch, "Notch")
+}
+
+// A player with everything that is saved changed from the defaults.
+func changedPlayer(w *world.World) *world.Player {
+	p := newPlayer(w)
+	p.SetPosition(data.Vec3{X: 12.5, Y: 70, Z: -3.25})
+	p.SetRotation(90, -45)
+	p.SetOnGround(true)
+	p.GameMode = world.GameModeSurvival
+	p.SetStats(world.Stats{
+		Health:         13.5,
+		FoodLevel:      17,
+		FoodSaturation: 2.5,
+		FoodExhaustion: 1.25,
+		XPLevel:        30,
+		XPProgress:     0.5,
+		XPTotal:        1395,
+	})
+	name := "components"
+	p.SetInventory([]world.InventoryItem{
+		{Slot: 0, ID: "minecraft:diamond_sword", Count: 1, Components: data.NBTCompoundValue(&name, []*data.NBTValue{
+			data.NBTIntValue("minecraft:damage", 12),
+		})},
+		{Slot: 9, ID: "minecraft:stone", Count: 64},
+		{Slot: 103, ID: "minecraft:iron_helmet", Count: 1},
+		{Slot: -106, ID: "minecraft:shield", Count: 1},
+	})
+	return p
+}
+
+// Checks that got has the state of want that is saved.
+func checkSameState(t *testing.T, got, want *world.Player) {
+	t.Helper()
+	if got.Position() != want.Position() {
+		t.Errorf("got position %v, want %v", got.Position(), want.Position())
+	}
+	gotYaw, gotPitch := got.Rotation()
+	wantYaw, wantPitch := want.Rotation()
+	if gotYaw != wantYaw || gotPitch != wantPitch {
+		t.Errorf("got rotation %v %v, want %v %v", gotYaw, gotPitch, wantYaw, wantPitch)
+	}
+	if got.GameMode != want.GameMode {
+		t.Errorf("got game mode %v, want %v", got.GameMode, want.GameMode)
+	}
+	if got.Stats() != want.Stats() {
+		t.Errorf("got stats %+v, want %+v", got.Stats(), want.Stats())
+	}
+	if !reflect.DeepEqual(got.Inventory(), want.Inventory()) {
+		t.Errorf("got inventory %+v, want %+v", got.Inventory(), want.Inventory())
+	}
+}
+
+func TestSnapshotApply(t *testing.T) {
+	w := world.NewWorld()
+	want := changedPlayer(w)
+	root := snapshot(want, data.NBTCompoundValue(nil, nil))
+
+	// Through the binary format, like the file
+	var buf data.Buffer
+	if err := root.WriteNamed(&buf); err != nil {
+		t.Fatal(err)
+	}
+	read := data.NewBufferFromBytes(buf.Raw)
+	decoded, err := data.ReadNamedNBT(&read)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := newPlayer(w)
+	apply(got, decoded)
+	checkSameState(t, got, want)
+
+	if uuidInts, _ := getValue[[]int32](decoded, "UUID"); !reflect.DeepEqual(uuidInts, []int32{0x069a79f4, 0x44e94726, -0x5a410357, 0x0e38aaf5}) {
+		t.Errorf("got UUID %x", uuidInts)
+	}
+	if onGround, _ := getValue[byte](decoded, "OnGround"); onGround != 1 {
+		t.Errorf("got OnGround %d, want 1", onGround)
+	}
+}
+
+func TestStoreRoundTrip(t *testing.T) {
+	w := world.NewWorld()
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := newPlayer(w)
+	if ok, err := s.Load(got); ok || err != nil {
+		t.Fatalf("Load before saving = %v, %v", ok, err)
+	}
+
+	want := changedPlayer(w)
+	if err := s.Save(want); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := s.Load(got); !ok || err != nil {
+		t.Fatalf("Load = %v, %v", ok, err)
+	}
+	checkSameState(t, got, want)
+}
+
+// Writes a player file, as vanilla would.
+func writeFile(t *testing.T, s *Store, root *data.NBTValue) {
+	t.Helper()
+	var buf data.Buffer
+	if err := root.WriteNamed(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	zw.Write(buf.Raw)
+	zw.Close()
+	if err := os.WriteFile(s.path(notch), compressed.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUnknownEntriesAreKept(t *testing.T) {
+	w := world.NewWorld()
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, s, data.NBTCompoundValue(nil, []*data.NBTValue{
+		data.NBTIntValue("SelectedItemSlot", 4),
+		data.NBTFloatValue("Health", 5),
+		data.NBTStringValue("SpawnDimension", "minecraft:the_nether"),
+	}))
+
+	p := changedPlayer(w)
+	if err := s.Save(p); err != nil {
+		t.Fatal(err)
+	}
+	root, err := s.read(notch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := root.Value.([]*data.NBTValue)
+	// In their original order, followed by the new ones
+	var names []string
+	for _, entry := range entries[:4] {
+		names = append(names, *entry.Name)
+	}
+	if want := []string{"SelectedItemSlot", "Health", "SpawnDimension", "DataVersion"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got entries %v first, want %v", names, want)
+	}
+	if slot, _ := getValue[int32](root, "SelectedItemSlot"); slot != 4 {
+		t.Errorf("got SelectedItemSlot %d, want 4", slot)
+	}
+	if health, _ := getValue[float32](root, "Health"); health != 13.5 {
+		t.Errorf("got Health %v, want the player's 13.5", health)
+	}
+}
+
+func TestApplyOldAndBadEntries(t *testing.T) {
+	w := world.NewWorld()
+	tests := []struct {
+		name    string
+		entries []*data.NBTValue
+		check   func(t *testing.T, p *world.Player)
+	}{
+		{"OtherDimension", []*data.NBTValue{
+			data.NBTStringValue("Dimension", "minecraft:the_nether"),
+			data.NBTListValue("Pos", []*data.NBTValue{data.NBTDoubleValue("", 10), data.NBTDoubleValue("", 70), data.NBTDoubleValue("", 10)}),
+		}, func(t *testing.T, p *world.Player) {
+			if p.Position() != w.Spawn {
+				t.Errorf("got position %v, want the spawn", p.Position())
+			}
+		}},
+		{"NotLoaded", []*data.NBTValue{
+			data.NBTListValue("Pos", []*data.NBTValue{data.NBTDoubleValue("", 1e6), data.NBTDoubleValue("", 70), data.NBTDoubleValue("", 10)}),
+		}, func(t *testing.T, p *world.Player) {
+			if p.Position() != w.Spawn {
+				t.Errorf("got position %v, want the spawn", p.Position())
+			}
+		}},
+		{"NotFinite", []*data.NBTValue{
+			data.NBTListValue("Pos", []*data.NBTValue{data.NBTDoubleValue("", math.NaN()), data.NBTDoubleValue("", 70), data.NBTDoubleValue("", 10)}),
+		}, func(t *testing.T, p *world.Player) {
+			if p.Position() != w.Spawn {
+				t.Errorf("got position %v, want the spawn", p.Position())
+			}
+		}},
+		{"ShortPos", []*data.NBTValue{
+			data.NBTListValue("Pos", []*data.NBTValue{data.NBTDoubleValue("", 10), data.NBTDoubleValue("", 70)}),
+		}, func(t *testing.T, p *world.Player) {
+			if p.Position() != w.Spawn {
+				t.Errorf("got position %v, want the spawn", p.Position())
+			}
+		}},
+		{"BadGameMode", []*data.NBTValue{
+			data.NBTIntValue("playerGameType", 7),
+		}, func(t *testing.T, p *world.Player) {
+			if p.GameMode != world.GameModeCreative {
+				t.Errorf("got game mode %v, want it unchanged", p.GameMode)
+			}
+		}},
+		{"MistypedStat", []*data.NBTValue{
+			data.NBTDoubleValue("Health", 5),
+			data.NBTIntValue("foodLevel", 3),
+		}, func(t *testing.T, p *world.Player) {
+			want := world.DefaultStats
+			want.FoodLevel = 3
+			if p.Stats() != want {
+				t.Errorf("got stats %+v, want %+v", p.Stats(), want)
+			}
+		}},
+		{"OldItems", []*data.NBTValue{
+			data.NBTListValue("Inventory", []*data.NBTValue{
+				// Before 1.20.5, with Count
+				data.NBTCompoundValue(nil, []*data.NBTValue{
+					data.NBTByteValue("Slot", 2), data.NBTStringValue("id", "minecraft:stone"), data.NBTByteValue("Count", 32),
+				}),
+				data.NBTCompoundValue(nil, []*data.NBTValue{
+					data.NBTByteValue("Slot", 3), data.NBTStringValue("id", "minecraft:dirt"), data.NBTByteValue("Count", 0),
+				}),
+				data.NBTCompoundValue(nil, []*data.NBTValue{
+					data.NBTByteValue("Slot", 4), data.NBTStringValue("id", ""),
+				}),
+				data.NBTCompoundValue(nil, []*data.NBTValue{
+					data.NBTStringValue("id", "minecraft:stone"),
+				}),
+				// Without a count there is one
+				data.NBTCompoundValue(nil, []*data.NBTValue{
+					data.NBTByteValue("Slot", 0x96), data.NBTStringValue("id", "minecraft:shield"),
+				}),
+			}),
+		}, func(t *testing.T, p *world.Player) {
+			want := []world.InventoryItem{
+				{Slot: 2, ID: "minecraft:stone", Count: 32},
+				{Slot: -106, ID: "minecraft:shield", Count: 1},
+			}
+			if !reflect.DeepEqual(p.Inventory(), want) {
+				t.Errorf("got inventory %+v, want %+v", p.Inventory(), want)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newPlayer(w)
+			apply(p, data.NBTCompoundValue(nil, tt.entries))
+			tt.check(t, p)
+		})
+	}
+}
+
+func TestUnreadableFile(t *testing.T) {
+	w := world.NewWorld()
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(s.path(notch), []byte("not gzip"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Load(newPlayer(w)); err == nil {
+		t.Fatal("loaded an unreadable file")
+	}
+
+	// Saving replaces it
+	want := changedPlayer(w)
+	if err := s.Save(want); err != nil {
+		t.Fatal(err)
+	}
+	got := newPlayer(w)
+	if ok, err := s.Load(got); !ok || err != nil {
+		t.Fatalf("Load = %v, %v", ok, err)
+	}
+	checkSameState(t, got, want)
+}
+
+func TestSaveAllSkipsReplaced(t *testing.T) {
+	w := world.NewWorld()
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := changedPlayer(w)
+	p.SetReplaced()
+	s.SaveAll([]*world.Player{p})
+	if _, err := os.Stat(s.path(notch)); !os.IsNotExist(err) {
+		t.Errorf("saved a replaced session: %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/brenfwd/gocraft/network/forwarding"
+)
+
+// Session server endpoint used to authenticate players in online mode. Tests
+// can point it at a fake.
+var SessionServerHasJoinedURL = "https://sessionserver.mojang.com/session/minecraft/hasJoined"
+
+var sessionClient = &http.Client{Timeout: 10 * time.Second}
+
+var errNotAuthenticated = errors.New("player has not joined with the session server")
+
+type profile struct {
+	ID         string                `json:"id"`
+	Name       string                `json:"name"`
+	Properties []forwarding.Property `json:"properties"`
+}
+
+// Asks the session server whether the player authenticated for this
+// connection, and returns their profile.
+func hasJoined(username string, serverHash string) (*profile, error) {
+	query := url.Values{"username": {username}, "serverId": {serverHash}}
+	res, err := sessionClient.Get(SessionServerHasJoinedURL + "?" + query.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent:
+		return nil, errNotAuthenticated
+	default:
+		return nil, fmt.Errorf("session server: %s", res.Status)
+	}
+
+	var p profile
+	if err := json.NewDecoder(res.Body).Decode(&p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
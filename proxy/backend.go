@@ -0,0 +1,139 @@
+package proxy
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/forwarding"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/network/messages/serverbound"
+)
+
+const (
+	connectTimeout = 5 * time.Second
+	// Time a backend has to log the player in
+	backendLoginTimeout = 10 * time.Second
+)
+
+// A connection to a backend server on behalf of a player.
+type backend struct {
+	name string
+	conn *network.ClientConnection
+}
+
+func (b *backend) close() {
+	b.conn.Close()
+}
+
+// Returned when a backend disconnects the player, with a reason to show them.
+type kickError struct {
+	server string
+	reason *data.Chat
+}
+
+func (e *kickError) Error() string {
+	return fmt.Sprintf("kicked by %s: %s", e.server, e.reason.PlainText())
+}
+
+// Connects to a backend server and logs the player in, up to Login Success.
+// The backend is left in the login state, waiting for Login Acknowledged.
+func (p *Proxy) connectBackend(player *Player, host string, name string) (*backend, error) {
+	address := p.config.Servers[name]
+	_, portString, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return nil, err
+	}
+	netConn, err := net.DialTimeout("tcp", address, connectTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &backend{name: name, conn: network.NewClientConnection(netConn)}
+	b.conn.SetDeadline(time.Now().Add(backendLoginTimeout))
+	if err := b.login(player, host, uint16(port), p.config.Forwarding); err != nil {
+		b.close()
+		return nil, err
+	}
+	b.conn.SetDeadline(time.Time{})
+	return b, nil
+}
+
+func (b *backend) send(protocol constants.ProtocolVersion, msg any) error {
+	packet, err := messages.EncodeServerbound(protocol, msg)
+	if err != nil {
+		return err
+	}
+	return b.conn.WritePacket(&packet)
+}
+
+func (b *backend) login(player *Player, host string, port uint16, config forwarding.Config) error {
+	info := &forwarding.PlayerInfo{
+		Address:    player.Address,
+		UUID:       player.UUID,
+		Username:   player.Username,
+		Properties: player.Properties,
+	}
+	if config.Mode == forwarding.ModeBungeeCord {
+		var err error
+		if host, err = forwarding.BungeeCordAddress(host, info); err != nil {
+			return err
+		}
+	}
+	err := b.send(player.Protocol, &serverbound.HandshakingServerboundHandshake{
+		ProtocolVersion: data.VarInt(player.Protocol),
+		ServerAddress:   host,
+		ServerPort:      port,
+		NextState:       data.VarInt(constants.ClientStateLogin),
+	})
+	if err != nil {
+		return err
+	}
+	err = b.send(player.Protocol, &serverbound.LoginServerboundLoginStart{Name: player.Username, PlayerUUID: player.UUID})
+	if err != nil {
+		return err
+	}
+
+	for {
+		packet, err := b.conn.ReadPacket()
+		if err != nil {
+			return err
+		}
+		msg, err := messages.DecodeClientbound(player.Protocol, constants.ClientStateLogin, &packet)
+		if err != nil {
+			return err
+		}
+
+		switch m := msg.(type) {
+		case *clientbound.LoginClientboundDisconnect:
+			return &kickError{server: b.name, reason: &m.Reason}
+		case *clientbound.LoginClientboundEncryptionRequest:
+			return fmt.Errorf("%s is in online mode, backends must be in offline mode", b.name)
+		case *clientbound.LoginClientboundSetCompression:
+			b.conn.SetCompression(int(m.Threshold))
+		case *clientbound.LoginClientboundLoginPluginRequest:
+			res := serverbound.LoginServerboundLoginPluginResponse{MessageID: m.MessageID}
+			if m.Channel == forwarding.VelocityChannel && config.Mode == forwarding.ModeVelocity {
+				response, err := forwarding.VelocityResponse(config.Secret, info)
+				if err != nil {
+					return err
+				}
+				res.Data = &response
+			}
+			if err := b.send(player.Protocol, &res); err != nil {
+				return err
+			}
+		case *clientbound.LoginClientboundSuccess:
+			return nil
+		}
+	}
+}
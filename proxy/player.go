@@ -0,0 +1,76 @@
+package proxy
+
+import (
+	"net/netip"
+	"sync"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network/forwarding"
+	"github.com/google/uuid"
+)
+
+// A player connected to the proxy. Safe for concurrent use.
+type Player struct {
+	Username   string
+	UUID       uuid.UUID
+	Properties []forwarding.Property
+	Address    netip.Addr
+	Protocol   constants.ProtocolVersion
+
+	// Requests handled by the player's session, see session.handleRequest
+	requests chan any
+	done     <-chan struct{}
+
+	mu     sync.Mutex
+	server string
+}
+
+type connectRequest struct {
+	server string
+}
+
+type messageRequest struct {
+	text string
+}
+
+type kickRequest struct {
+	reason *data.Chat
+}
+
+// The server the player is on, empty while they are being moved.
+func (p *Player) Server() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.server
+}
+
+func (p *Player) setServer(server string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.server = server
+}
+
+// Moves the player to another server. If it can't be joined, the player stays
+// where they are and is told why.
+func (p *Player) Connect(server string) {
+	p.request(connectRequest{server: server})
+}
+
+// Sends the player a chat message from the proxy.
+func (p *Player) SendMessage(text string) {
+	p.request(messageRequest{text: text})
+}
+
+// Disconnects the player from the proxy.
+func (p *Player) Kick(reason *data.Chat) {
+	p.request(kickRequest{reason: reason})
+}
+
+// Requests to players that already left are dropped.
+func (p *Player) request(r any) {
+	select {
+	case p.requests <- r:
+	case <-p.done:
+	}
+}
@@ -0,0 +1,151 @@
+package proxy
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/forwarding"
+	"github.com/google/uuid"
+)
+
+type Config struct {
+	// Backend servers by name, e.g. "lobby": "10.0.0.2:25565"
+	Servers map[string]string
+	// Server players join unless a route matches
+	Default string
+	// Server for players connecting with a (lowercase) hostname, e.g.
+	// "survival.example.com": "survival"
+	Routes map[string]string
+	// Server players are moved to when theirs disconnects them. Empty to
+	// disconnect them from the proxy instead.
+	Fallback string
+	// How player details are passed on to the backends, which should run in
+	// offline mode with the same forwarding configured.
+	Forwarding forwarding.Config
+	// Authenticate players with the session server. Otherwise they get
+	// offline-mode UUIDs.
+	OnlineMode bool
+	// Limits on incoming connections
+	Throttle network.ThrottleConfig
+	// Load balancers in front of the proxy that send a PROXY protocol header
+	TrustedProxies []netip.Prefix
+}
+
+// Accepts players and relays their connection to a backend server, which they
+// can be moved between.
+type Proxy struct {
+	config   Config
+	listener network.Listener
+
+	mu      sync.Mutex
+	players map[uuid.UUID]*Player
+}
+
+func NewProxy(host string, port uint16, config Config) (*Proxy, error) {
+	if _, ok := config.Servers[config.Default]; !ok {
+		return nil, fmt.Errorf("default server %q is not configured", config.Default)
+	}
+	for host, server := range config.Routes {
+		if _, ok := config.Servers[server]; !ok {
+			return nil, fmt.Errorf("route for %s: server %q is not configured", host, server)
+		}
+	}
+	if _, ok := config.Servers[config.Fallback]; config.Fallback != "" && !ok {
+		return nil, fmt.Errorf("fallback server %q is not configured", config.Fallback)
+	}
+
+	listener, err := network.NewListener(host, port)
+	if err != nil {
+		return nil, err
+	}
+	return &Proxy{
+		config:   config,
+		listener: listener,
+		players:  make(map[uuid.UUID]*Player),
+	}, nil
+}
+
+func (p *Proxy) Addr() net.Addr {
+	return p.listener.Addr()
+}
+
+// Accepts connections until Close is called.
+func (p *Proxy) Run() {
+	p.listener.Throttle = network.NewThrottler(p.config.Throttle)
+	p.listener.TrustedProxies = p.config.TrustedProxies
+	go p.listener.Listen()
+
+	log.Println("Proxy is ready")
+
+	var wg sync.WaitGroup
+	for conn := range p.listener.Incoming {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			newSession(p, conn).run()
+		}()
+	}
+	wg.Wait()
+}
+
+func (p *Proxy) Close() error {
+	log.Println("gocraft proxy is shutting down...")
+	for _, player := range p.Players() {
+		player.Kick(data.MakeChat().SetText("Proxy is shutting down"))
+	}
+	return p.listener.Close()
+}
+
+// The players currently connected.
+func (p *Proxy) Players() []*Player {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	players := make([]*Player, 0, len(p.players))
+	for _, player := range p.players {
+		players = append(players, player)
+	}
+	return players
+}
+
+func (p *Proxy) Player(id uuid.UUID) *Player {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.players[id]
+}
+
+// Adds a player that finished logging in, unless one with the same UUID is
+// already connected.
+func (p *Proxy) addPlayer(player *Player) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.players[player.UUID]; ok {
+		return false
+	}
+	p.players[player.UUID] = player
+	return true
+}
+
+func (p *Proxy) removePlayer(player *Player) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.players[player.UUID] == player {
+		delete(p.players, player.UUID)
+	}
+}
+
+// The server for a handshake's server address, which clients send as typed
+// (possibly with a trailing dot, or with Forge's "\x00FML\x00" marker).
+func (p *Proxy) route(serverAddress string) string {
+	host, _, _ := strings.Cut(serverAddress, "\x00")
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if server, ok := p.config.Routes[host]; ok {
+		return server
+	}
+	return p.config.Default
+}
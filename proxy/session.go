@@ -0,0 +1,667 @@
+package proxy
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/encryption"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/network/messages/serverbound"
+	"github.com/google/uuid"
+)
+
+// Longest server address vanilla accepts in the handshake.
+const maxServerAddress = 255
+
+// A client's connection to the proxy. Like core.Client, everything happens on
+// the session's goroutine: packets from the client and the backend, finished
+// backend connections and requests from other goroutines are all handled in
+// run's loop.
+type session struct {
+	proxy    *Proxy
+	conn     *network.Connection
+	state    constants.ClientState
+	protocol constants.ProtocolVersion
+	// Server address from the handshake, passed on to backends
+	host   string
+	player *Player
+	// Whether the client has been sent Login Success
+	loggedIn bool
+	// The client's last settings, replayed to each new backend
+	settings *serverbound.ConfigurationServerboundClientInformation
+
+	// The server the player is on, nil while they are being moved
+	backend *backend
+	// A server the player is being moved to, attached once the client
+	// acknowledged entering the configuration state
+	pending *backend
+	// Name of the server being connected to, empty if none
+	connecting string
+	// Shown to the player once they are in the play state on their new server
+	notice *data.Chat
+
+	backendPackets chan backendPacket
+	connected      chan connectResult
+	requests       chan any
+	done           chan struct{}
+}
+
+type backendPacket struct {
+	backend *backend
+	packet  network.Packet
+	err     error
+}
+
+type connectResult struct {
+	name    string
+	backend *backend
+	err     error
+}
+
+var errDisconnected = errors.New("client was disconnected")
+
+func newSession(p *Proxy, conn *network.Connection) *session {
+	return &session{
+		proxy:          p,
+		conn:           conn,
+		state:          constants.ClientStateHandshaking,
+		protocol:       constants.ProtocolLatest,
+		backendPackets: make(chan backendPacket, 64),
+		connected:      make(chan connectResult, 1),
+		requests:       make(chan any, 16),
+		done:           make(chan struct{}),
+	}
+}
+
+func (s *session) run() {
+	go s.conn.Receive()
+	defer s.close()
+
+	err := s.handshake()
+	if err == nil {
+		err = s.login()
+	}
+	if err == nil {
+		err = s.relay()
+	}
+	if err != nil && !errors.Is(err, errDisconnected) {
+		log.Printf("Proxy session %s: %v", s.conn.RemoteAddr(), err)
+	}
+}
+
+func (s *session) close() {
+	close(s.done)
+	if s.player != nil {
+		s.proxy.removePlayer(s.player)
+		log.Printf("%s left the proxy", s.player.Username)
+	}
+	if s.backend != nil {
+		s.backend.close()
+	}
+	if s.pending != nil {
+		s.pending.close()
+	}
+	s.conn.Close()
+}
+
+// Waits for the client's next packet. Returns errDisconnected once the
+// connection is closed.
+func (s *session) next() (network.Packet, error) {
+	select {
+	case packet := <-s.conn.Packets:
+		return packet, nil
+	case <-s.conn.Eof:
+		return network.Packet{}, errDisconnected
+	}
+}
+
+// Reads the next packet, which must be a T.
+func receive[T any](s *session) (*T, error) {
+	packet, err := s.next()
+	if err != nil {
+		return nil, err
+	}
+	defer packet.Release()
+	msg, err := messages.DecodeServerbound(s.protocol, s.state, &packet)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := msg.(*T)
+	if !ok {
+		return nil, fmt.Errorf("expected %v, got %T", reflect.TypeFor[T](), msg)
+	}
+	return m, nil
+}
+
+func (s *session) send(msg any) error {
+	packet, err := messages.EncodeFor(s.protocol, msg)
+	if err != nil {
+		return err
+	}
+	return s.conn.WritePacket(&packet, network.PriorityNormal)
+}
+
+func (s *session) handshake() error {
+	hs, err := receive[serverbound.HandshakingServerboundHandshake](s)
+	if err != nil {
+		return err
+	}
+	if len(hs.ServerAddress) > maxServerAddress {
+		return fmt.Errorf("server address too long (%d bytes)", len(hs.ServerAddress))
+	}
+	s.host, _, _ = strings.Cut(hs.ServerAddress, "\x00")
+
+	protocol := constants.ProtocolVersion(hs.ProtocolVersion)
+	switch constants.ClientState(hs.NextState) {
+	case constants.ClientStateStatus:
+		return s.status(hs)
+	case constants.ClientStateLogin, constants.ClientStateTransfer:
+		s.state = constants.ClientStateLogin
+		if !protocol.Supported() {
+			return s.disconnect(data.MakeChat().SetText(fmt.Sprintf("Unsupported version, please use %s", constants.SupportedVersionsName())))
+		}
+		s.protocol = protocol
+		return nil
+	default:
+		return fmt.Errorf("invalid next state %v", hs.NextState)
+	}
+}
+
+// Relays a server list ping to the server the client would join, so that it
+// shows that server's status.
+func (s *session) status(hs *serverbound.HandshakingServerboundHandshake) error {
+	conn, err := network.Dial(s.proxy.config.Servers[s.proxy.route(hs.ServerAddress)])
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	packet, err := messages.EncodeServerbound(constants.ProtocolLatest, hs)
+	if err != nil {
+		return err
+	}
+	if err := conn.WritePacket(&packet); err != nil {
+		return err
+	}
+	go func() {
+		defer s.conn.Close()
+		for {
+			packet, err := conn.ReadPacket()
+			if err != nil {
+				return
+			}
+			s.conn.WritePacket(&packet, network.PriorityNormal)
+			if s.conn.Flush() != nil {
+				return
+			}
+		}
+	}()
+	for {
+		packet, err := s.next()
+		if err != nil {
+			return nil
+		}
+		err = conn.WritePacket(&packet)
+		packet.Release()
+		if err != nil {
+			return nil
+		}
+	}
+}
+
+// Authenticates the client and starts connecting it to its first server,
+// which sends Login Success once it's done.
+func (s *session) login() error {
+	start, err := receive[serverbound.LoginServerboundLoginStart](s)
+	if err != nil {
+		return err
+	}
+	if !data.ValidUsername(start.Name) {
+		return s.disconnect(data.MakeChat().SetText("Invalid username: use 3 to 16 letters, digits or underscores"))
+	}
+
+	var verifyToken [4]byte
+	rand.Read(verifyToken[:])
+	keypair := s.conn.Keypair
+	err = s.send(&clientbound.LoginClientboundEncryptionRequest{
+		ServerID:           "",
+		PublicKey:          keypair.PublicKey,
+		VerifyToken:        verifyToken[:],
+		ShouldAuthenticate: s.proxy.config.OnlineMode,
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.conn.Flush(); err != nil {
+		return err
+	}
+
+	res, err := receive[serverbound.LoginServerboundEncryptionResponse](s)
+	if err != nil {
+		return err
+	}
+	sharedSecret, token := res.SharedSecret, res.VerifyToken
+	if err := keypair.DecryptWithPrivateKey(&sharedSecret); err != nil {
+		return err
+	}
+	if err := keypair.DecryptWithPrivateKey(&token); err != nil {
+		return err
+	}
+	if !bytes.Equal(token, verifyToken[:]) {
+		return errors.New("verify token mismatch")
+	}
+	crypter, err := encryption.NewCrypter(sharedSecret)
+	if err != nil {
+		return err
+	}
+	s.conn.SetCrypter(crypter)
+
+	// The client's address, which may come from a PROXY protocol header
+	addrPort, err := netip.ParseAddrPort(s.conn.RemoteAddr().String())
+	if err != nil {
+		return err
+	}
+	player := &Player{
+		Username: start.Name,
+		UUID:     data.OfflineUUID(start.Name),
+		Address:  addrPort.Addr().Unmap(),
+		Protocol: s.protocol,
+		requests: s.requests,
+		done:     s.done,
+	}
+	if s.proxy.config.OnlineMode {
+		profile, err := hasJoined(start.Name, encryption.ServerHash("", sharedSecret, keypair.PublicKey))
+		if err != nil {
+			log.Printf("Authenticating %s: %v", start.Name, err)
+			// Same wording as vanilla
+			return s.disconnect(data.MakeChat().SetText("Failed to verify username!"))
+		}
+		id, err := uuid.Parse(profile.ID)
+		if err != nil {
+			return err
+		}
+		player.UUID, player.Username, player.Properties = id, profile.Name, profile.Properties
+	}
+
+	if !s.proxy.addPlayer(player) {
+		return s.disconnect(data.MakeChat().SetText("You are already connected to this proxy!"))
+	}
+	s.player = player
+	log.Printf("%s (%s) joined the proxy from %s", player.Username, player.UUID, s.conn.RemoteAddr())
+
+	s.connect(s.proxy.route(s.host))
+	return nil
+}
+
+// Relays packets between the client and its backend until the client leaves.
+func (s *session) relay() error {
+	for {
+		if err := s.conn.Flush(); err != nil {
+			return err
+		}
+
+		var err error
+		select {
+		case <-s.conn.Eof:
+			return errDisconnected
+		case packet := <-s.conn.Packets:
+			err = s.fromClient(&packet)
+			packet.Release()
+		case bp := <-s.backendPackets:
+			err = s.fromBackend(bp)
+		case result := <-s.connected:
+			err = s.onConnected(result)
+		case request := <-s.requests:
+			err = s.handleRequest(request)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *session) fromClient(packet *network.Packet) error {
+	switch s.state {
+	case constants.ClientStateLogin:
+		if isServerbound[serverbound.LoginServerboundLoginAcknowledged](s, packet) {
+			s.state = constants.ClientStateConfiguration
+		}
+	case constants.ClientStateConfiguration:
+		if isServerbound[serverbound.ConfigurationServerboundClientInformation](s, packet) {
+			if err := s.saveSettings(packet); err != nil {
+				return err
+			}
+		}
+		if isServerbound[serverbound.ConfigurationServerboundAcknowledgeFinishConfiguration](s, packet) {
+			s.state = constants.ClientStatePlay
+			s.conn.LoginComplete()
+		}
+	case constants.ClientStatePlay:
+		if isServerbound[serverbound.PlayServerboundClientInformation](s, packet) {
+			if err := s.saveSettings(packet); err != nil {
+				return err
+			}
+		}
+		if isServerbound[serverbound.PlayServerboundConfigurationAcknowledged](s, packet) {
+			s.state = constants.ClientStateConfiguration
+			if s.pending != nil {
+				// Acknowledges a move, rather than the backend's own
+				// reconfiguration
+				b := s.pending
+				s.pending = nil
+				return s.attach(b)
+			}
+		}
+		if isServerbound[serverbound.PlayServerboundChatCommand](s, packet) {
+			msg, err := messages.DecodeServerbound(s.protocol, s.state, packet)
+			if err != nil {
+				return err
+			}
+			command := msg.(*serverbound.PlayServerboundChatCommand).Command
+			if name, args, _ := strings.Cut(command, " "); name == "server" {
+				s.serverCommand(strings.TrimSpace(args))
+				return nil
+			}
+		}
+	}
+
+	// Packets sent while the player is between servers are dropped
+	if s.backend != nil {
+		if err := s.backend.conn.WritePacket(packet); err != nil {
+			// The backend's reader reports the lost connection
+			s.backend.close()
+		}
+	}
+	return nil
+}
+
+func (s *session) saveSettings(packet *network.Packet) error {
+	msg, err := messages.DecodeServerbound(s.protocol, s.state, packet)
+	if err != nil {
+		return err
+	}
+	switch m := msg.(type) {
+	case *serverbound.ConfigurationServerboundClientInformation:
+		s.settings = m
+	case *serverbound.PlayServerboundClientInformation:
+		settings := serverbound.ConfigurationServerboundClientInformation(*m)
+		s.settings = &settings
+	}
+	return nil
+}
+
+func (s *session) fromBackend(bp backendPacket) error {
+	if bp.backend != s.backend {
+		// From a server the player already left
+		return nil
+	}
+	if bp.err != nil {
+		log.Printf("%s lost connection to %s: %v", s.player.Username, bp.backend.name, bp.err)
+		return s.backendLost(data.MakeChat().SetText(fmt.Sprintf("Lost connection to %s", bp.backend.name)))
+	}
+
+	packet := &bp.packet
+	var login bool
+	switch s.state {
+	case constants.ClientStateConfiguration:
+		if isClientbound[clientbound.ConfigurationClientboundDisconnect](s, packet) {
+			msg, err := messages.DecodeClientbound(s.protocol, s.state, packet)
+			if err != nil {
+				return err
+			}
+			m := msg.(*clientbound.ConfigurationClientboundDisconnect)
+			if m.Reason != nil {
+				return s.backendLost(data.ChatFromNBT(m.Reason))
+			}
+			return s.backendLost(&m.ReasonJSON)
+		}
+	case constants.ClientStatePlay:
+		if isClientbound[clientbound.PlayClientboundDisconnect](s, packet) {
+			msg, err := messages.DecodeClientbound(s.protocol, s.state, packet)
+			if err != nil {
+				return err
+			}
+			m := msg.(*clientbound.PlayClientboundDisconnect)
+			if m.Reason != nil {
+				return s.backendLost(data.ChatFromNBT(m.Reason))
+			}
+			return s.backendLost(&m.ReasonJSON)
+		}
+		login = isClientbound[clientbound.PlayClientboundLogin](s, packet)
+	}
+
+	if err := s.conn.WritePacket(packet, network.PriorityNormal); err != nil {
+		return err
+	}
+	if login && s.notice != nil {
+		s.sendMessage(s.notice)
+		s.notice = nil
+	}
+	return nil
+}
+
+// Moves the player to the fallback server after their backend disconnected
+// them, or disconnects them with the same reason if there is none.
+func (s *session) backendLost(reason *data.Chat) error {
+	lost := s.backend
+	s.backend = nil
+	lost.close()
+	s.player.setServer("")
+
+	notice := data.MakeChat().SetText(fmt.Sprintf("You were moved to %s: %s", s.proxy.config.Fallback, reason.PlainText()))
+	if s.connecting != "" {
+		// Already on the way to another server
+		s.notice = notice
+		return nil
+	}
+	if s.proxy.config.Fallback == "" || s.proxy.config.Fallback == lost.name {
+		return s.disconnect(reason)
+	}
+	s.notice = notice
+	s.connect(s.proxy.config.Fallback)
+	return nil
+}
+
+// Starts connecting to a backend in the background. The result is handled by
+// onConnected.
+func (s *session) connect(name string) {
+	s.connecting = name
+	go func() {
+		b, err := s.proxy.connectBackend(s.player, s.host, name)
+		select {
+		case s.connected <- connectResult{name: name, backend: b, err: err}:
+		case <-s.done:
+			if b != nil {
+				b.close()
+			}
+		}
+	}()
+}
+
+func (s *session) onConnected(result connectResult) error {
+	s.connecting = ""
+	if result.err != nil {
+		log.Printf("Connecting %s to %s: %v", s.player.Username, result.name, result.err)
+		reason := data.MakeChat().SetText(fmt.Sprintf("Could not connect to %s: %v", result.name, result.err))
+		var kick *kickError
+		if errors.As(result.err, &kick) {
+			reason = data.MakeChat().SetText(fmt.Sprintf("Could not connect to %s: %s", result.name, kick.reason.PlainText()))
+		}
+		if s.backend != nil {
+			// The player stays where they are
+			s.sendMessage(reason)
+			return nil
+		}
+		fallback := s.proxy.config.Fallback
+		if fallback == "" || fallback == result.name {
+			return s.disconnect(reason)
+		}
+		s.notice = data.MakeChat().SetText(fmt.Sprintf("You were moved to %s: %s", fallback, reason.PlainText()))
+		s.connect(fallback)
+		return nil
+	}
+
+	b := result.backend
+	log.Printf("%s connected to %s", s.player.Username, b.name)
+	if !s.loggedIn {
+		s.loggedIn = true
+		properties := make([]clientbound.LoginClientboundSuccess_Property, len(s.player.Properties))
+		for i, p := range s.player.Properties {
+			properties[i] = clientbound.LoginClientboundSuccess_Property(p)
+		}
+		err := s.send(&clientbound.LoginClientboundSuccess{
+			UUID:       s.player.UUID,
+			Username:   s.player.Username,
+			Properties: properties,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.backend != nil {
+		s.backend.close()
+		s.backend = nil
+	}
+	if s.state != constants.ClientStatePlay {
+		return s.attach(b)
+	}
+	// The client has to re-enter the configuration state first
+	if s.pending != nil {
+		s.pending.close()
+	}
+	s.pending = b
+	return s.send(&clientbound.PlayClientboundStartConfiguration{})
+}
+
+// Makes b the player's server. The client must be in the login or
+// configuration state.
+func (s *session) attach(b *backend) error {
+	s.backend = b
+	// Clients in the login state acknowledge Login Success themselves
+	if s.state == constants.ClientStateConfiguration {
+		err := b.send(s.protocol, &serverbound.LoginServerboundLoginAcknowledged{})
+		if err == nil && s.settings != nil {
+			err = b.send(s.protocol, s.settings)
+		}
+		if err != nil {
+			log.Printf("%s lost connection to %s: %v", s.player.Username, b.name, err)
+			return s.backendLost(data.MakeChat().SetText(fmt.Sprintf("Lost connection to %s", b.name)))
+		}
+	}
+	s.player.setServer(b.name)
+	go s.read(b)
+	return nil
+}
+
+// Reads a backend's packets into backendPackets until its connection closes.
+func (s *session) read(b *backend) {
+	for {
+		packet, err := b.conn.ReadPacket()
+		select {
+		case s.backendPackets <- backendPacket{backend: b, packet: packet, err: err}:
+		case <-s.done:
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *session) handleRequest(request any) error {
+	switch r := request.(type) {
+	case connectRequest:
+		s.moveTo(r.server)
+	case messageRequest:
+		s.sendMessage(data.MakeChat().SetText(r.text))
+	case kickRequest:
+		return s.disconnect(r.reason)
+	}
+	return nil
+}
+
+func (s *session) serverCommand(name string) {
+	if name == "" {
+		servers := make([]string, 0, len(s.proxy.config.Servers))
+		for server := range s.proxy.config.Servers {
+			servers = append(servers, server)
+		}
+		slices.Sort(servers)
+		s.sendMessage(data.MakeChat().SetText(fmt.Sprintf("You are on %s. Servers: %s", s.player.Server(), strings.Join(servers, ", "))))
+		return
+	}
+	s.moveTo(name)
+}
+
+func (s *session) moveTo(name string) {
+	switch {
+	case s.proxy.config.Servers[name] == "":
+		s.sendMessage(data.MakeChat().SetText(fmt.Sprintf("Unknown server %s", name)))
+	case s.connecting != "":
+		s.sendMessage(data.MakeChat().SetText(fmt.Sprintf("Already connecting to %s", s.connecting)))
+	case s.backend != nil && s.backend.name == name:
+		s.sendMessage(data.MakeChat().SetText(fmt.Sprintf("You are already connected to %s", name)))
+	default:
+		s.connect(name)
+	}
+}
+
+// Sends a chat message from the proxy, if the client is in the play state.
+func (s *session) sendMessage(message *data.Chat) {
+	if s.state != constants.ClientStatePlay {
+		return
+	}
+	err := s.send(&clientbound.PlayClientboundSystemChatMessage{
+		ContentJSON: *message,
+		Content:     message.ToNBT(nil),
+	})
+	if err != nil {
+		log.Println("Error sending message:", err)
+	}
+}
+
+// Sends the disconnect packet for the client's state, then returns
+// errDisconnected to end the session.
+func (s *session) disconnect(reason *data.Chat) error {
+	var msg any
+	switch s.state {
+	case constants.ClientStateLogin:
+		msg = &clientbound.LoginClientboundDisconnect{Reason: *reason}
+	case constants.ClientStateConfiguration:
+		msg = &clientbound.ConfigurationClientboundDisconnect{ReasonJSON: *reason, Reason: reason.ToNBT(nil)}
+	case constants.ClientStatePlay:
+		msg = &clientbound.PlayClientboundDisconnect{ReasonJSON: *reason, Reason: reason.ToNBT(nil)}
+	default:
+		return errDisconnected
+	}
+	packet, err := messages.EncodeFor(s.protocol, msg)
+	if err != nil {
+		return err
+	}
+	s.conn.WritePacket(&packet, network.PriorityHigh)
+	return errDisconnected
+}
+
+// Whether a packet from the client is a T, without decoding it.
+func isServerbound[T any](s *session, packet *network.Packet) bool {
+	t, ok := messages.LookupServerbound(s.protocol, s.state, packet.Id)
+	return ok && t == reflect.TypeFor[T]()
+}
+
+// Whether a packet from the backend is a T, without decoding it.
+func isClientbound[T any](s *session, packet *network.Packet) bool {
+	v, ok := messages.LookupClientbound(s.protocol, reflect.TypeFor[T]())
+	return ok && v.State == s.state && v.Id == packet.Id
+}
@@ -0,0 +1,145 @@
+package scheduler
+
+import (
+	"container/heap"
+	"log"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+// A scheduled function. Repeating tasks run every Period ticks until cancelled.
+type Task struct {
+	fn        func()
+	runAt     uint64
+	period    uint64
+	cancelled atomic.Bool
+	index     int // in taskHeap
+}
+
+func (t *Task) Cancel() {
+	t.cancelled.Store(true)
+}
+
+func (t *Task) Cancelled() bool {
+	return t.cancelled.Load()
+}
+
+type taskHeap []*Task
+
+func (h taskHeap) Len() int           { return len(h) }
+func (h taskHeap) Less(i, j int) bool { return h[i].runAt < h[j].runAt }
+func (h taskHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *taskHeap) Push(x any) {
+	t := x.(*Task)
+	t.index = len(*h)
+	*h = append(*h, t)
+}
+
+func (h *taskHeap) Pop() any {
+	old := *h
+	t := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return t
+}
+
+// Runs functions on the main (tick) thread. All methods are safe to call from
+// any goroutine; the functions themselves only ever run inside RunTick.
+type Scheduler struct {
+	mu      sync.Mutex
+	tick    uint64
+	pending []func()
+	tasks   taskHeap
+}
+
+func New() *Scheduler {
+	return &Scheduler{}
+}
+
+// Number of ticks run so far.
+func (s *Scheduler) CurrentTick() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tick
+}
+
+// Runs fn at the start of the next tick. This is how network goroutines hand
+// work to the main thread.
+func (s *Scheduler) Execute(fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = append(s.pending, fn)
+}
+
+// Runs fn once, `delay` ticks from now. A delay of 0 runs it next tick.
+func (s *Scheduler) RunLater(delay uint64, fn func()) *Task {
+	return s.schedule(delay, 0, fn)
+}
+
+// Runs fn every `period` ticks, starting `delay` ticks from now.
+func (s *Scheduler) RunRepeating(delay, period uint64, fn func()) *Task {
+	if period == 0 {
+		period = 1
+	}
+	return s.schedule(delay, period, fn)
+}
+
+func (s *Scheduler) schedule(delay, period uint64, fn func()) *Task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := &Task{fn: fn, runAt: s.tick + 1 + delay, period: period}
+	heap.Push(&s.tasks, t)
+	return t
+}
+
+// Advances the clock by one tick: runs functions queued with Execute, then
+// every task that is due. Must only be called from the tick loop.
+func (s *Scheduler) RunTick() {
+	s.mu.Lock()
+	s.tick++
+	now := s.tick
+	pending := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+
+	for _, fn := range pending {
+		run(fn)
+	}
+
+	for {
+		s.mu.Lock()
+		if len(s.tasks) == 0 || s.tasks[0].runAt > now {
+			s.mu.Unlock()
+			return
+		}
+		t := heap.Pop(&s.tasks).(*Task)
+		s.mu.Unlock()
+
+		if t.Cancelled() {
+			continue
+		}
+		run(t.fn)
+		if t.period > 0 && !t.Cancelled() {
+			s.mu.Lock()
+			t.runAt = now + t.period
+			heap.Push(&s.tasks, t)
+			s.mu.Unlock()
+		}
+	}
+}
+
+// A panicking task shouldn't take the server down with it.
+func run(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Scheduled task panicked: %v\n%s", r, debug.Stack())
+		}
+	}()
+	fn()
+}
@@ -2,11 +2,24 @@ package shared
 
 import (
 	"crypto/rand"
+	"log"
+	"net"
 	"sync"
+	"time"
 
+	"github.com/brenfwd/gocraft/access"
+	"github.com/brenfwd/gocraft/command"
 	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/event"
 	"github.com/brenfwd/gocraft/network"
 	"github.com/brenfwd/gocraft/network/encryption"
+	"github.com/brenfwd/gocraft/network/forwarding"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/playerdata"
+	"github.com/brenfwd/gocraft/scheduler"
+	"github.com/brenfwd/gocraft/world"
 	"github.com/google/uuid"
 )
 
@@ -14,11 +27,91 @@ type ClientMessage interface{}
 type ClientShared struct {
 	Mutex                 sync.Mutex
 	C                     chan *ClientMessage
+	RemoteAddr            net.Addr
 	ListenerKeypair       *encryption.KeypairBytes
 	EncryptionVerifyToken [4]byte
 	AllegedUsername       string
 	AllegedUUID           uuid.UUID
 	SharedSecret          []byte
+	World                 *world.World
+	Scheduler             *scheduler.Scheduler // for running work on the tick thread
+	Events                *event.Bus
+	Player                *world.Player // set once the client enters the play state
+	// Protocol version of the client, set from the handshake if the server
+	// supports it. Messages are decoded and encoded for this version.
+	Protocol constants.ProtocolVersion
+	// How the proxy in front of the server, if any, forwards player details
+	Forwarding forwarding.Config
+	// Whitelist, operators and bans; nil if logins aren't checked
+	Access      *access.Lists
+	Commands    *command.Dispatcher
+	Permissions world.PermissionSource
+	// Where players are saved; nil if they aren't
+	PlayerData *playerdata.Store
+	// The player details the proxy forwarded, once known
+	Forwarded *forwarding.PlayerInfo
+	// Login plugin requests waiting for the client's answer, by message ID
+	loginPluginRequests map[int32]*loginPluginRequest
+	nextLoginPluginID   int32
+	// ID of the last keep alive sent, while the client hasn't answered it
+	keepAliveID      int64
+	keepAlivePending bool
+	// Closed once the client has to be dropped because C was full, so that
+	// senders never wait on a client that stopped keeping up
+	overflow     chan struct{}
+	overflowOnce sync.Once
+	overflowWhy  *data.Chat
+	// Holds a flush request from the tick loop until the client's goroutine
+	// gets to it
+	flush chan struct{}
+}
+
+// Queues a message for the client's goroutine without blocking. If the queue is
+// full the client is too far behind to catch up, so it is disconnected with
+// reason instead.
+func (i *ClientShared) post(msg ClientMessage, reason *data.Chat) {
+	select {
+	case i.C <- &msg:
+	default:
+		i.overflowOnce.Do(func() {
+			i.overflowWhy = reason
+			close(i.overflow)
+		})
+	}
+}
+
+var reasonTooFarBehind = data.MakeChat().SetText("Too many queued messages")
+
+// Closed when the client's message queue overflowed; the client's goroutine
+// should then disconnect it with OverflowReason.
+func (i *ClientShared) Overflow() <-chan struct{} {
+	return i.overflow
+}
+
+// Only valid once Overflow is closed.
+func (i *ClientShared) OverflowReason() *data.Chat {
+	return i.overflowWhy
+}
+
+// Has the client's goroutine send everything queued for it once it has handled
+// the messages already in C. Called after every tick, so that what a tick sends
+// goes out in one write. Never blocks; requests made before the last one was
+// handled are merged.
+func (i *ClientShared) RequestFlush() {
+	select {
+	case i.flush <- struct{}{}:
+	default:
+	}
+}
+
+// Receives the requests made with RequestFlush.
+func (i *ClientShared) FlushRequests() <-chan struct{} {
+	return i.flush
+}
+
+// Protocol version of the client, for world.PacketSender.
+func (i *ClientShared) ProtocolVersion() constants.ProtocolVersion {
+	return i.Protocol
 }
 
 type ClientChangeState struct {
@@ -26,8 +119,7 @@ type ClientChangeState struct {
 }
 
 func (i *ClientShared) ChangeState(newState constants.ClientState) {
-	cm := ClientMessage(ClientChangeState{NewState: newState})
-	i.C <- &cm
+	i.post(ClientChangeState{NewState: newState}, reasonTooFarBehind)
 }
 
 type ClientSend struct {
@@ -35,27 +127,168 @@ type ClientSend struct {
 }
 
 func (i *ClientShared) SendPacket(packet *network.Packet) {
-	cm := ClientMessage(ClientSend{Packet: packet})
-	i.C <- &cm
+	i.post(ClientSend{Packet: packet}, reasonTooFarBehind)
 }
 
 type ClientEnableEncryption struct{}
 
 func (i *ClientShared) EnableEncryption() {
-	cm := ClientMessage(ClientEnableEncryption{})
-	i.C <- &cm
+	i.post(ClientEnableEncryption{}, reasonTooFarBehind)
+}
+
+type ClientDisconnect struct {
+	Reason *data.Chat
+}
+
+// Sends the disconnect packet for the current state with the given reason, then
+// closes the connection once everything queued before it has been sent.
+func (i *ClientShared) Disconnect(reason *data.Chat) {
+	i.post(ClientDisconnect{Reason: reason}, reason)
+}
+
+type ClientKeepAlive struct{}
+
+// Has the client's goroutine send a keep alive, or disconnect the client if it
+// didn't answer the last one. Called by the tick loop.
+func (i *ClientShared) KeepAlive() {
+	i.post(ClientKeepAlive{}, reasonTooFarBehind)
+}
+
+var ReasonTimedOut = data.MakeChat().SetText("Timed out")
+
+// Returns the ID of the next keep alive to send, or false if the client never
+// answered the last one and has timed out.
+func (i *ClientShared) NextKeepAlive() (int64, bool) {
+	if i.keepAlivePending {
+		return 0, false
+	}
+	i.keepAliveID = time.Now().UnixMilli()
+	i.keepAlivePending = true
+	return i.keepAliveID, true
+}
+
+// Checks the client's answer to a keep alive. Like vanilla, an answer that
+// doesn't match the keep alive sent disconnects the client.
+func (i *ClientShared) AnswerKeepAlive(id int64) {
+	if !i.keepAlivePending || id != i.keepAliveID {
+		log.Printf("Unexpected keep alive %d from %s", id, i.RemoteAddr)
+		i.Disconnect(ReasonTimedOut)
+		return
+	}
+	i.keepAlivePending = false
 }
 
+type ClientLoginPluginTimeout struct {
+	MessageID int32
+}
+
+// Sends a login plugin request and calls callback with the client's answer, or
+// with TimedOut set if there is none within timeout. An error from callback
+// closes the connection. Only valid in the login state, from the client's
+// goroutine (i.e. in a packet handler).
+func (i *ClientShared) SendLoginPluginRequest(channel string, payload []byte, timeout time.Duration, callback func(event.LoginPluginResponse) error) error {
+	id := i.nextLoginPluginID
+	i.nextLoginPluginID++
+	encoded, err := messages.Encode(&clientbound.LoginClientboundLoginPluginRequest{
+		MessageID: data.VarInt(id),
+		Channel:   channel,
+		Data:      payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	if i.loginPluginRequests == nil {
+		i.loginPluginRequests = make(map[int32]*loginPluginRequest)
+	}
+	request := &loginPluginRequest{callback: callback}
+	request.timer = time.AfterFunc(timeout, func() {
+		i.post(ClientLoginPluginTimeout{MessageID: id}, reasonTooFarBehind)
+	})
+	i.loginPluginRequests[id] = request
+	i.SendPacket(&encoded)
+	return nil
+}
+
+// Passes the client's answer (or its absence) to the callback of the request
+// with the given message ID. Answers to unknown or expired requests are
+// ignored.
+func (i *ClientShared) AnswerLoginPluginRequest(id int32, response event.LoginPluginResponse) error {
+	request, ok := i.loginPluginRequests[id]
+	if !ok {
+		log.Printf("Ignoring answer to unknown login plugin request %d from %s", id, i.RemoteAddr)
+		return nil
+	}
+	delete(i.loginPluginRequests, id)
+	request.timer.Stop()
+	return request.callback(response)
+}
+
+// Stops the timeouts of unanswered login plugin requests, so they don't fire
+// for a client that is gone. Called once the connection has ended.
+func (i *ClientShared) CancelLoginPluginRequests() {
+	for id, request := range i.loginPluginRequests {
+		request.timer.Stop()
+		delete(i.loginPluginRequests, id)
+	}
+}
+
+type loginPluginRequest struct {
+	callback func(event.LoginPluginResponse) error
+	timer    *time.Timer
+}
+
+// Replaces the alleged player details and the remote address with the ones the
+// proxy forwarded.
+func (i *ClientShared) Forward(info *forwarding.PlayerInfo) {
+	i.Forwarded = info
+	i.AllegedUUID = info.UUID
+	if info.Username != "" {
+		i.AllegedUsername = info.Username
+	}
+	var port int
+	if tcp, ok := i.RemoteAddr.(*net.TCPAddr); ok {
+		port = tcp.Port
+	}
+	i.RemoteAddr = &net.TCPAddr{IP: info.Address.AsSlice(), Port: port}
+}
+
+// Messages a client can have queued before it is disconnected.
 const maxClientMessages = 1024
 
-func NewClientShared(keypair *encryption.KeypairBytes) *ClientShared {
+// Server-wide state a client needs access to.
+type ServerContext struct {
+	World     *world.World
+	Scheduler *scheduler.Scheduler
+	Events    *event.Bus
+	// Passed on to ClientShared.Forwarding
+	Forwarding  forwarding.Config
+	Access      *access.Lists
+	Commands    *command.Dispatcher
+	Permissions world.PermissionSource
+	PlayerData  *playerdata.Store
+}
+
+func NewClientShared(remoteAddr net.Addr, keypair *encryption.KeypairBytes, ctx ServerContext) *ClientShared {
 	// All channels have to be buffered because the channel is sent data during a select statement
 	// so it must be buffered to prevent blocking since nothing will read from it until the select
 	// statement is re-run.
 
 	cs := ClientShared{
 		C:               make(chan *ClientMessage, maxClientMessages),
+		overflow:        make(chan struct{}),
+		flush:           make(chan struct{}, 1),
+		RemoteAddr:      remoteAddr,
 		ListenerKeypair: keypair,
+		World:           ctx.World,
+		Scheduler:       ctx.Scheduler,
+		Events:          ctx.Events,
+		Forwarding:      ctx.Forwarding,
+		Access:          ctx.Access,
+		Commands:        ctx.Commands,
+		Permissions:     ctx.Permissions,
+		PlayerData:      ctx.PlayerData,
+		Protocol:        constants.ProtocolLatest,
 	}
 	rand.Read(cs.EncryptionVerifyToken[:])
 
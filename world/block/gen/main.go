@@ -0,0 +1,272 @@
+// Generates the block state registry from the vanilla data generator's
+// blocks.json report.
+//
+// To update to a new version, download its server jar and run
+//
+//	go run ./gen -server-jar server.jar
+//
+// from world/block. This runs the jar's data generator (which needs Java):
+//
+//	java -DbundlerMainClass=net.minecraft.data.Main -jar server.jar --reports
+//
+// copies its blocks.json report over world/block/blocks.json and generates the
+// registry from it. `go generate ./world/block` regenerates from the committed
+// report.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+type property struct {
+	Name   string
+	Values []string
+}
+
+type state struct {
+	ID         int               `json:"id"`
+	Default    bool              `json:"default"`
+	Properties map[string]string `json:"properties"`
+}
+
+type block struct {
+	Name       string
+	Properties []property
+	States     []state
+}
+
+// Reads a JSON object key by key. encoding/json maps do not preserve key order,
+// but both the block order and the property order of the report are significant.
+func readObject(dec *json.Decoder, field func(key string) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("expected object but got %v", tok)
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if err := field(tok.(string)); err != nil {
+			return err
+		}
+	}
+	_, err = dec.Token()
+	return err
+}
+
+func readReport(r io.Reader) ([]block, error) {
+	dec := json.NewDecoder(r)
+	var blocks []block
+	err := readObject(dec, func(name string) error {
+		b := block{Name: name}
+		err := readObject(dec, func(key string) error {
+			switch key {
+			case "properties":
+				return readObject(dec, func(propName string) error {
+					p := property{Name: propName}
+					if err := dec.Decode(&p.Values); err != nil {
+						return err
+					}
+					b.Properties = append(b.Properties, p)
+					return nil
+				})
+			case "states":
+				return dec.Decode(&b.States)
+			default:
+				// e.g. "definition", which we don't need
+				var skip json.RawMessage
+				return dec.Decode(&skip)
+			}
+		})
+		blocks = append(blocks, b)
+		return err
+	})
+	return blocks, err
+}
+
+// Checks that the state IDs in the report match the layout assumed by the
+// registry: contiguous, first property varying slowest.
+func validate(b *block) (base int, def int, err error) {
+	if len(b.States) == 0 {
+		return 0, 0, fmt.Errorf("block %s has no states", b.Name)
+	}
+	base = b.States[0].ID
+	def = -1
+	for _, s := range b.States {
+		offset := 0
+		for _, p := range b.Properties {
+			vi := -1
+			for i, v := range p.Values {
+				if v == s.Properties[p.Name] {
+					vi = i
+				}
+			}
+			if vi < 0 {
+				return 0, 0, fmt.Errorf("block %s state %d has invalid value for %s", b.Name, s.ID, p.Name)
+			}
+			offset = offset*len(p.Values) + vi
+		}
+		if s.ID != base+offset {
+			return 0, 0, fmt.Errorf("block %s state %d does not match expected ID %d", b.Name, s.ID, base+offset)
+		}
+		if s.Default {
+			def = s.ID
+		}
+	}
+	if def < 0 {
+		return 0, 0, fmt.Errorf("block %s has no default state", b.Name)
+	}
+	return base, def, nil
+}
+
+// Every version since 1.19 has more blocks than this, so a report with fewer is
+// a partial copy rather than one from the data generator.
+const minBlocks = 1000
+
+// Checks that the report covers the whole block state registry: a report that
+// was cut short would still generate, but the client would see different
+// blocks for the missing states.
+func checkComplete(blocks []block) error {
+	if len(blocks) < minBlocks {
+		return fmt.Errorf("only %d blocks, expected at least %d; is the report truncated?", len(blocks), minBlocks)
+	}
+	if blocks[0].Name != "minecraft:air" {
+		return fmt.Errorf("first block is %s, expected minecraft:air", blocks[0].Name)
+	}
+	next := 0
+	for _, b := range blocks {
+		if len(b.States) == 0 || b.States[0].ID != next {
+			return fmt.Errorf("block %s does not start at state %d; is the report missing blocks?", b.Name, next)
+		}
+		next += len(b.States)
+	}
+	return nil
+}
+
+// minecraft:oak_stairs -> OakStairs
+func goName(name string) string {
+	_, path, _ := strings.Cut(name, ":")
+	var sb strings.Builder
+	for _, part := range strings.Split(path, "_") {
+		if part == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return sb.String()
+}
+
+func generate(blocks []block) ([]byte, error) {
+	var out bytes.Buffer
+	fmt.Fprintln(&out, "// Code generated by \"go run ./gen\"; DO NOT EDIT.")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "package block")
+	fmt.Fprintln(&out)
+
+	fmt.Fprintln(&out, "const (")
+	for i, b := range blocks {
+		fmt.Fprintf(&out, "\t%s BlockID = %d\n", goName(b.Name), i)
+	}
+	fmt.Fprintln(&out, ")")
+	fmt.Fprintln(&out)
+
+	fmt.Fprintln(&out, "var blocks = []Block{")
+	for i := range blocks {
+		b := &blocks[i]
+		base, def, err := validate(b)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&out, "\t{ID: %d, Name: %q, BaseState: %d, DefaultState: %d", i, b.Name, base, def)
+		if len(b.Properties) > 0 {
+			fmt.Fprint(&out, ", Properties: []Property{")
+			for _, p := range b.Properties {
+				fmt.Fprintf(&out, "{Name: %q, Values: %#v}, ", p.Name, p.Values)
+			}
+			fmt.Fprint(&out, "}")
+		}
+		fmt.Fprintln(&out, "},")
+	}
+	fmt.Fprintln(&out, "}")
+
+	return format.Source(out.Bytes())
+}
+
+// Runs the server jar's data generator and copies its blocks.json report to
+// reportPath.
+func runReports(serverJar string, reportPath string) error {
+	jar, err := filepath.Abs(serverJar)
+	if err != nil {
+		return err
+	}
+	dir, err := os.MkdirTemp("", "gocraft-reports")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	cmd := exec.Command("java", "-DbundlerMainClass=net.minecraft.data.Main", "-jar", jar, "--reports", "--output", filepath.Join(dir, "generated"))
+	// The bundler unpacks the server's libraries next to where it runs
+	cmd.Dir = dir
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("running the data generator: %w", err)
+	}
+	report, err := os.ReadFile(filepath.Join(dir, "generated", "reports", "blocks.json"))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(reportPath, report, 0644)
+}
+
+func main() {
+	reportPath := flag.String("report", "blocks.json", "path to the vanilla blocks.json report")
+	outPath := flag.String("out", "blocks_gen.go", "output Go file")
+	serverJar := flag.String("server-jar", "", "vanilla server jar to produce the report with first, replacing the one at -report")
+	flag.Parse()
+
+	if *serverJar != "" {
+		if err := runReports(*serverJar, *reportPath); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	f, err := os.Open(*reportPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	blocks, err := readReport(f)
+	if err != nil {
+		log.Fatal("reading report: ", err)
+	}
+	if err := checkComplete(blocks); err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := generate(blocks)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*outPath, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Generated %d blocks into %s", len(blocks), *outPath)
+}
@@ -0,0 +1,92 @@
+package block
+
+// Typed accessors for commonly used block properties. Each getter returns
+// false as its second value if the block does not have the property.
+
+type Facing string
+
+const (
+	FacingDown  Facing = "down"
+	FacingUp    Facing = "up"
+	FacingNorth Facing = "north"
+	FacingSouth Facing = "south"
+	FacingWest  Facing = "west"
+	FacingEast  Facing = "east"
+)
+
+type Half string
+
+const (
+	// Stairs and trapdoors
+	HalfTop    Half = "top"
+	HalfBottom Half = "bottom"
+	// Doors and tall plants
+	HalfUpper Half = "upper"
+	HalfLower Half = "lower"
+)
+
+type Axis string
+
+const (
+	AxisX Axis = "x"
+	AxisY Axis = "y"
+	AxisZ Axis = "z"
+)
+
+func (s State) Facing() (Facing, bool) {
+	v, ok := s.Property("facing")
+	return Facing(v), ok
+}
+
+func (s State) WithFacing(f Facing) (State, error) {
+	return s.With("facing", string(f))
+}
+
+func (s State) Half() (Half, bool) {
+	v, ok := s.Property("half")
+	return Half(v), ok
+}
+
+func (s State) WithHalf(h Half) (State, error) {
+	return s.With("half", string(h))
+}
+
+func (s State) Axis() (Axis, bool) {
+	v, ok := s.Property("axis")
+	return Axis(v), ok
+}
+
+func (s State) WithAxis(a Axis) (State, error) {
+	return s.With("axis", string(a))
+}
+
+func (s State) Waterlogged() (bool, bool) {
+	return s.boolProperty("waterlogged")
+}
+
+func (s State) WithWaterlogged(v bool) (State, error) {
+	if v {
+		return s.With("waterlogged", "true")
+	}
+	return s.With("waterlogged", "false")
+}
+
+func (s State) Powered() (bool, bool) {
+	return s.boolProperty("powered")
+}
+
+func (s State) Open() (bool, bool) {
+	return s.boolProperty("open")
+}
+
+func (s State) Snowy() (bool, bool) {
+	return s.boolProperty("snowy")
+}
+
+func (s State) Age() (int, bool) {
+	return s.intProperty("age")
+}
+
+func (s State) Level() (int, bool) {
+	return s.intProperty("level")
+}
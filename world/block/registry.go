@@ -0,0 +1,227 @@
+package block
+
+import (
+	"fmt"
+	"strconv"
+)
+
+//go:generate go run ./gen -report blocks.json -out blocks_gen.go
+
+// Numeric block state ID as sent in chunk sections and block update packets.
+type StateID int32
+
+// Index of a block type in the block registry.
+type BlockID int32
+
+type Property struct {
+	Name   string
+	Values []string
+}
+
+// Index of value in the property's value list, or -1.
+func (p *Property) index(value string) int {
+	for i, v := range p.Values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// A block type with its properties. The states of a block are laid out
+// contiguously starting at BaseState, with the first property varying slowest
+// and the last property varying fastest (this is how vanilla assigns IDs).
+type Block struct {
+	ID           BlockID
+	Name         string
+	Properties   []Property
+	BaseState    StateID
+	DefaultState StateID
+}
+
+func (b *Block) StateCount() int {
+	n := 1
+	for _, p := range b.Properties {
+		n *= len(p.Values)
+	}
+	return n
+}
+
+func (b *Block) Default() State {
+	return State{ID: b.DefaultState, Block: b}
+}
+
+func (b *Block) property(name string) (int, *Property) {
+	for i := range b.Properties {
+		if b.Properties[i].Name == name {
+			return i, &b.Properties[i]
+		}
+	}
+	return -1, nil
+}
+
+// Returns the state of this block with the given property values. Properties
+// that are not given take their value from the default state.
+func (b *Block) State(properties map[string]string) (State, error) {
+	indexes := b.Default().indexes()
+	for name, value := range properties {
+		i, p := b.property(name)
+		if p == nil {
+			return State{}, fmt.Errorf("block %s has no property %q", b.Name, name)
+		}
+		vi := p.index(value)
+		if vi < 0 {
+			return State{}, fmt.Errorf("block %s property %q has no value %q", b.Name, name, value)
+		}
+		indexes[i] = vi
+	}
+	return b.stateFromIndexes(indexes), nil
+}
+
+func (b *Block) stateFromIndexes(indexes []int) State {
+	offset := 0
+	for i, p := range b.Properties {
+		offset = offset*len(p.Values) + indexes[i]
+	}
+	return State{ID: b.BaseState + StateID(offset), Block: b}
+}
+
+func (id BlockID) Block() *Block {
+	if id < 0 || int(id) >= len(blocks) {
+		return nil
+	}
+	return &blocks[id]
+}
+
+// A single block state, i.e. a block together with a value for each of its properties.
+type State struct {
+	ID    StateID
+	Block *Block
+}
+
+// Value index of each property of the state, in property order.
+func (s State) indexes() []int {
+	indexes := make([]int, len(s.Block.Properties))
+	offset := int(s.ID - s.Block.BaseState)
+	for i := len(s.Block.Properties) - 1; i >= 0; i-- {
+		n := len(s.Block.Properties[i].Values)
+		indexes[i] = offset % n
+		offset /= n
+	}
+	return indexes
+}
+
+func (s State) Properties() map[string]string {
+	props := make(map[string]string, len(s.Block.Properties))
+	for i, vi := range s.indexes() {
+		p := &s.Block.Properties[i]
+		props[p.Name] = p.Values[vi]
+	}
+	return props
+}
+
+func (s State) Property(name string) (string, bool) {
+	i, p := s.Block.property(name)
+	if p == nil {
+		return "", false
+	}
+	return p.Values[s.indexes()[i]], true
+}
+
+// Returns the state with a single property changed.
+func (s State) With(name, value string) (State, error) {
+	i, p := s.Block.property(name)
+	if p == nil {
+		return State{}, fmt.Errorf("block %s has no property %q", s.Block.Name, name)
+	}
+	vi := p.index(value)
+	if vi < 0 {
+		return State{}, fmt.Errorf("block %s property %q has no value %q", s.Block.Name, name, value)
+	}
+	indexes := s.indexes()
+	indexes[i] = vi
+	return s.Block.stateFromIndexes(indexes), nil
+}
+
+func (s State) String() string {
+	if len(s.Block.Properties) == 0 {
+		return s.Block.Name
+	}
+	str := s.Block.Name + "["
+	for i, vi := range s.indexes() {
+		if i > 0 {
+			str += ","
+		}
+		p := &s.Block.Properties[i]
+		str += p.Name + "=" + p.Values[vi]
+	}
+	return str + "]"
+}
+
+func (s State) boolProperty(name string) (bool, bool) {
+	v, ok := s.Property(name)
+	if !ok {
+		return false, false
+	}
+	b, err := strconv.ParseBool(v)
+	return b, err == nil
+}
+
+func (s State) intProperty(name string) (int, bool) {
+	v, ok := s.Property(name)
+	if !ok {
+		return 0, false
+	}
+	i, err := strconv.Atoi(v)
+	return i, err == nil
+}
+
+// Registry lookups
+
+var (
+	blocksByName = make(map[string]*Block)
+	stateBlocks  []*Block // indexed by state ID
+)
+
+func init() {
+	for i := range blocks {
+		b := &blocks[i]
+		blocksByName[b.Name] = b
+		end := int(b.BaseState) + b.StateCount()
+		for len(stateBlocks) < end {
+			stateBlocks = append(stateBlocks, nil)
+		}
+		for id := int(b.BaseState); id < end; id++ {
+			stateBlocks[id] = b
+		}
+	}
+}
+
+// Looks up a block by its namespaced name (e.g. "minecraft:stone").
+func ByName(name string) (*Block, bool) {
+	b, ok := blocksByName[name]
+	return b, ok
+}
+
+// Looks up a block state by its numeric ID.
+func ByStateID(id StateID) (State, bool) {
+	if id < 0 || int(id) >= len(stateBlocks) || stateBlocks[id] == nil {
+		return State{}, false
+	}
+	return State{ID: id, Block: stateBlocks[id]}, true
+}
+
+// Looks up a block state by name and property values, e.g.
+// Lookup("minecraft:oak_log", map[string]string{"axis": "x"}).
+func Lookup(name string, properties map[string]string) (State, error) {
+	b, ok := ByName(name)
+	if !ok {
+		return State{}, fmt.Errorf("unknown block %q", name)
+	}
+	return b.State(properties)
+}
+
+// Number of block states in the registry.
+func StateCount() int {
+	return len(stateBlocks)
+}
@@ -0,0 +1,73 @@
+package world
+
+import (
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/world/block"
+)
+
+const (
+	MinY = -64
+	MaxY = 319
+)
+
+// Block states of the flat terrain that fills the world wherever no block
+// has been set.
+func flatBlock(y int32) block.State {
+	switch {
+	case y == MinY:
+		return block.Bedrock.Block().Default()
+	case y < 60:
+		return block.Stone.Block().Default()
+	case y < 63:
+		return block.Dirt.Block().Default()
+	case y == 63:
+		return block.GrassBlock.Block().Default()
+	default:
+		return block.Air.Block().Default()
+	}
+}
+
+func (w *World) Block(pos data.Position) block.State {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if s, ok := w.blocks[pos]; ok {
+		return s
+	}
+	return flatBlock(pos.Y)
+}
+
+// Sets a block and sends the change to every player.
+func (w *World) SetBlock(pos data.Position, s block.State) error {
+	if pos.Y < MinY || pos.Y > MaxY {
+		return nil
+	}
+	w.mu.Lock()
+	w.blocks[pos] = s
+	w.mu.Unlock()
+	return w.SendBlock(nil, pos)
+}
+
+// Sends the current state of a block to a player, or to everyone if p is nil.
+// Used to revert client-side predictions of rejected changes.
+func (w *World) SendBlock(p *Player, pos data.Position) error {
+	encoded, err := blockUpdatePacket(pos, w.Block(pos))
+	if err != nil {
+		return err
+	}
+	if p != nil {
+		p.SendPacket(&encoded)
+	} else {
+		w.BroadcastExcept(nil, &encoded)
+	}
+	return nil
+}
+
+func blockUpdatePacket(pos data.Position, s block.State) (network.Packet, error) {
+	return messages.Encode(&clientbound.PlayClientboundBlockUpdate{
+		Location: pos,
+		BlockID:  data.VarInt(s.ID),
+	})
+}
@@ -0,0 +1,119 @@
+package entity
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/brenfwd/gocraft/data"
+	"github.com/google/uuid"
+)
+
+var lastID atomic.Int32
+
+// Allocates a new entity ID. IDs are unique across all worlds for the lifetime
+// of the server.
+func NewID() int32 {
+	return lastID.Add(1)
+}
+
+// Implemented by everything that can be tracked. Concrete entities embed *Base.
+type Entity interface {
+	EntityBase() *Base
+}
+
+// State shared by all entities.
+type Base struct {
+	ID       int32
+	UUID     uuid.UUID
+	Type     *Type
+	Metadata Metadata
+
+	// Value of the Data field of Spawn Entity, whose meaning depends on the type
+	// (e.g. the block state of a falling block).
+	SpawnData int32
+
+	mu       sync.Mutex
+	position data.Vec3
+	velocity data.Vec3 // blocks per tick
+	yaw      float32
+	pitch    float32
+	headYaw  float32
+	onGround bool
+}
+
+func NewBase(t *Type, id uuid.UUID, pos data.Vec3) *Base {
+	return &Base{
+		ID:       NewID(),
+		UUID:     id,
+		Type:     t,
+		position: pos,
+	}
+}
+
+func (e *Base) EntityBase() *Base {
+	return e
+}
+
+func (e *Base) Position() data.Vec3 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.position
+}
+
+func (e *Base) SetPosition(pos data.Vec3) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.position = pos
+}
+
+func (e *Base) Velocity() data.Vec3 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.velocity
+}
+
+func (e *Base) SetVelocity(v data.Vec3) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.velocity = v
+}
+
+func (e *Base) Rotation() (yaw, pitch float32) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.yaw, e.pitch
+}
+
+// Sets the body rotation. For most entities the head follows the body, so the
+// head yaw is updated too.
+func (e *Base) SetRotation(yaw, pitch float32) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.yaw = yaw
+	e.pitch = pitch
+	e.headYaw = yaw
+}
+
+func (e *Base) HeadYaw() float32 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.headYaw
+}
+
+func (e *Base) SetHeadYaw(yaw float32) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.headYaw = yaw
+}
+
+func (e *Base) OnGround() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.onGround
+}
+
+func (e *Base) SetOnGround(onGround bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.onGround = onGround
+}
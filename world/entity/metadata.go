@@ -0,0 +1,149 @@
+package entity
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/brenfwd/gocraft/data"
+)
+
+// Metadata indexes shared by all entities.
+const (
+	MetadataIndexFlags             byte = 0 // byte, see Flag*
+	MetadataIndexAirTicks          byte = 1 // VarInt
+	MetadataIndexCustomName        byte = 2 // OptTextComponent
+	MetadataIndexCustomNameVisible byte = 3 // Boolean
+	MetadataIndexSilent            byte = 4 // Boolean
+	MetadataIndexNoGravity         byte = 5 // Boolean
+	MetadataIndexPose              byte = 6 // Pose
+	MetadataIndexTicksFrozen       byte = 7 // VarInt
+)
+
+// Bits of the MetadataIndexFlags byte.
+const (
+	FlagOnFire     byte = 0x01
+	FlagCrouching  byte = 0x02
+	FlagSprinting  byte = 0x08
+	FlagSwimming   byte = 0x10
+	FlagInvisible  byte = 0x20
+	FlagGlowing    byte = 0x40
+	FlagFallFlying byte = 0x80
+)
+
+type Pose data.VarInt
+
+const (
+	PoseStanding Pose = iota
+	PoseFallFlying
+	PoseSleeping
+	PoseSwimming
+	PoseSpinAttack
+	PoseSneaking
+	PoseLongJumping
+	PoseDying
+	PoseCroaking
+	PoseUsingTongue
+	PoseSitting
+	PoseRoaring
+	PoseSniffing
+	PoseEmerging
+	PoseDigging
+	PoseSliding
+	PoseShooting
+	PoseInhaling
+)
+
+// Current metadata of an entity. Changed entries are remembered until they are
+// sent out with TakeDirty.
+type Metadata struct {
+	mu      sync.Mutex
+	entries map[byte]data.MetadataEntry
+	dirty   map[byte]bool
+}
+
+func (m *Metadata) Set(index byte, t data.MetadataType, value any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.entries == nil {
+		m.entries = make(map[byte]data.MetadataEntry)
+		m.dirty = make(map[byte]bool)
+	}
+	m.entries[index] = data.MetadataEntry{Index: index, Type: t, Value: value}
+	m.dirty[index] = true
+}
+
+func (m *Metadata) Get(index byte) (data.MetadataEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[index]
+	return entry, ok
+}
+
+func (m *Metadata) collect(filter func(index byte) bool) *data.EntityMetadata {
+	result := &data.EntityMetadata{}
+	for index, entry := range m.entries {
+		if filter(index) {
+			result.Entries = append(result.Entries, entry)
+		}
+	}
+	slices.SortFunc(result.Entries, func(a, b data.MetadataEntry) int {
+		return int(a.Index) - int(b.Index)
+	})
+	return result
+}
+
+// All entries, e.g. for a newly spawned entity.
+func (m *Metadata) All() *data.EntityMetadata {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.collect(func(byte) bool { return true })
+}
+
+// Entries changed since the last call, or nil if nothing changed.
+func (m *Metadata) TakeDirty() *data.EntityMetadata {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.dirty) == 0 {
+		return nil
+	}
+	result := m.collect(func(index byte) bool { return m.dirty[index] })
+	clear(m.dirty)
+	return result
+}
+
+// Helpers for the shared indexes
+
+func (m *Metadata) Flags() byte {
+	entry, ok := m.Get(MetadataIndexFlags)
+	if !ok {
+		return 0
+	}
+	return entry.Value.(byte)
+}
+
+func (m *Metadata) SetFlag(flag byte, set bool) {
+	flags := m.Flags()
+	if set {
+		flags |= flag
+	} else {
+		flags &^= flag
+	}
+	m.Set(MetadataIndexFlags, data.MetadataByte, flags)
+}
+
+func (m *Metadata) SetCustomName(name *data.Chat, visible bool) {
+	m.Set(MetadataIndexCustomName, data.MetadataOptTextComponent, name)
+	m.Set(MetadataIndexCustomNameVisible, data.MetadataBoolean, visible)
+}
+
+func (m *Metadata) SetSilent(silent bool) {
+	m.Set(MetadataIndexSilent, data.MetadataBoolean, silent)
+}
+
+func (m *Metadata) SetNoGravity(noGravity bool) {
+	m.Set(MetadataIndexNoGravity, data.MetadataBoolean, noGravity)
+}
+
+func (m *Metadata) SetPose(pose Pose) {
+	m.Set(MetadataIndexPose, data.MetadataPose, data.VarInt(pose))
+}
@@ -0,0 +1,308 @@
+package entity
+
+import (
+	"log"
+	"math"
+	"sync"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+)
+
+// An entity that is sent other entities, i.e. a player.
+type Viewer interface {
+	Entity
+	SendPacket(*network.Packet)
+	// Protocol version of the viewer's client, which decides e.g. the entity
+	// type IDs it is sent
+	Protocol() constants.ProtocolVersion
+}
+
+// Implemented by entities that have to be in a viewer's player list before
+// they can be spawned for it, i.e. players.
+type PlayerListed interface {
+	// Player Info Update adding the entity to the player list
+	PlayerInfo() (network.Packet, error)
+}
+
+type viewerState struct {
+	viewer Viewer
+	seen   map[int32]Entity
+}
+
+// Keeps track of which viewers know about which entities, and sends Spawn
+// Entity / Remove Entities / Set Entity Metadata as entities come in and out of
+// range. An entity is in range of a viewer if their horizontal distance is at
+// most the entity type's tracking range, capped by the view distance.
+type Tracker struct {
+	viewDistance int // chunks
+
+	mu       sync.Mutex
+	entities map[int32]Entity
+	viewers  map[int32]*viewerState
+}
+
+// A packet that still has to be sent. Sends are collected while the tracker is
+// locked and flushed afterwards.
+type pendingSend struct {
+	viewer Viewer
+	packet network.Packet
+}
+
+func NewTracker(viewDistance int) *Tracker {
+	return &Tracker{
+		viewDistance: viewDistance,
+		entities:     make(map[int32]Entity),
+		viewers:      make(map[int32]*viewerState),
+	}
+}
+
+func flush(sends []pendingSend) {
+	for i := range sends {
+		sends[i].viewer.SendPacket(&sends[i].packet)
+	}
+}
+
+func (t *Tracker) inRange(v Viewer, e Entity) bool {
+	base := e.EntityBase()
+	chunks := min(base.Type.TrackingRange, t.viewDistance)
+	r := float64(chunks * 16)
+	d := v.EntityBase().Position().Sub(base.Position())
+	return d.X*d.X+d.Z*d.Z <= r*r
+}
+
+// Starts tracking an entity and spawns it for the viewers in range.
+func (t *Tracker) Add(e Entity) {
+	t.mu.Lock()
+	t.entities[e.EntityBase().ID] = e
+	sends := t.updateEntity(e)
+	t.mu.Unlock()
+	flush(sends)
+}
+
+// Registers a viewer and spawns the entities in its range. The viewer itself
+// has to be Added separately to be visible to others.
+func (t *Tracker) AddViewer(v Viewer) {
+	t.mu.Lock()
+	vs := &viewerState{viewer: v, seen: make(map[int32]Entity)}
+	t.viewers[v.EntityBase().ID] = vs
+	sends := t.updateViewer(vs)
+	t.mu.Unlock()
+	flush(sends)
+}
+
+// Stops tracking an entity, removing it for everyone who saw it. If the entity
+// is a viewer it also stops being sent entities.
+func (t *Tracker) Remove(e Entity) {
+	id := e.EntityBase().ID
+
+	t.mu.Lock()
+	delete(t.entities, id)
+	delete(t.viewers, id)
+	var sends []pendingSend
+	for _, vs := range t.viewers {
+		if _, ok := vs.seen[id]; ok {
+			delete(vs.seen, id)
+			sends = t.appendRemove(sends, vs.viewer, []int32{id})
+		}
+	}
+	t.mu.Unlock()
+	flush(sends)
+}
+
+// Re-evaluates visibility after an entity moved: who can see it, and if it is a
+// viewer, what it can see.
+func (t *Tracker) Update(e Entity) {
+	t.mu.Lock()
+	sends := t.updateEntity(e)
+	if vs, ok := t.viewers[e.EntityBase().ID]; ok {
+		sends = append(sends, t.updateViewer(vs)...)
+	}
+	t.mu.Unlock()
+	flush(sends)
+}
+
+// Re-evaluates visibility of everything, e.g. once per tick.
+func (t *Tracker) UpdateAll() {
+	t.mu.Lock()
+	var sends []pendingSend
+	for _, vs := range t.viewers {
+		sends = append(sends, t.updateViewer(vs)...)
+	}
+	t.mu.Unlock()
+	flush(sends)
+}
+
+// Re-evaluates visibility and sends out pending metadata changes of every
+// entity. Called once per tick.
+func (t *Tracker) Tick() {
+	t.UpdateAll()
+
+	t.mu.Lock()
+	entities := make([]Entity, 0, len(t.entities))
+	for _, e := range t.entities {
+		entities = append(entities, e)
+	}
+	t.mu.Unlock()
+
+	for _, e := range entities {
+		t.SendMetadataUpdates(e)
+	}
+}
+
+// Sends a packet to every viewer currently tracking the entity.
+func (t *Tracker) Broadcast(e Entity, packet *network.Packet) {
+	id := e.EntityBase().ID
+	t.mu.Lock()
+	var viewers []Viewer
+	for _, vs := range t.viewers {
+		if _, ok := vs.seen[id]; ok {
+			viewers = append(viewers, vs.viewer)
+		}
+	}
+	t.mu.Unlock()
+	for _, v := range viewers {
+		v.SendPacket(packet)
+	}
+}
+
+// Sends changed metadata of the entity to its viewers (and to the entity itself
+// if it is a viewer, as clients need their own metadata too).
+func (t *Tracker) SendMetadataUpdates(e Entity) {
+	base := e.EntityBase()
+	dirty := base.Metadata.TakeDirty()
+	if dirty == nil {
+		return
+	}
+	encoded, err := messages.Encode(&clientbound.PlayClientboundSetEntityMetadata{
+		EntityID: data.VarInt(base.ID),
+		Metadata: dirty,
+	})
+	if err != nil {
+		log.Printf("Error encoding metadata of entity %d: %v", base.ID, err)
+		return
+	}
+	t.Broadcast(e, &encoded)
+	if v, ok := e.(Viewer); ok {
+		v.SendPacket(&encoded)
+	}
+}
+
+// Must be called with t.mu held.
+func (t *Tracker) updateEntity(e Entity) []pendingSend {
+	id := e.EntityBase().ID
+	var sends []pendingSend
+	for vid, vs := range t.viewers {
+		if vid == id {
+			continue
+		}
+		_, seen := vs.seen[id]
+		visible := t.inRange(vs.viewer, e)
+		if visible && !seen {
+			vs.seen[id] = e
+			sends = t.appendSpawn(sends, vs.viewer, e)
+		} else if !visible && seen {
+			delete(vs.seen, id)
+			sends = t.appendRemove(sends, vs.viewer, []int32{id})
+		}
+	}
+	return sends
+}
+
+// Must be called with t.mu held.
+func (t *Tracker) updateViewer(vs *viewerState) []pendingSend {
+	self := vs.viewer.EntityBase().ID
+	var sends []pendingSend
+	var removed []int32
+	for id, e := range t.entities {
+		if id == self {
+			continue
+		}
+		_, seen := vs.seen[id]
+		visible := t.inRange(vs.viewer, e)
+		if visible && !seen {
+			vs.seen[id] = e
+			sends = t.appendSpawn(sends, vs.viewer, e)
+		} else if !visible && seen {
+			delete(vs.seen, id)
+			removed = append(removed, id)
+		}
+	}
+	if len(removed) > 0 {
+		sends = t.appendRemove(sends, vs.viewer, removed)
+	}
+	return sends
+}
+
+// Velocity in Spawn Entity is in 1/8000 blocks per tick, clamped like vanilla.
+func encodeVelocity(v float64) int16 {
+	return int16(math.Max(-3.9, math.Min(3.9, v)) * 8000)
+}
+
+func (t *Tracker) appendSpawn(sends []pendingSend, v Viewer, e Entity) []pendingSend {
+	base := e.EntityBase()
+	pos := base.Position()
+	vel := base.Velocity()
+	yaw, pitch := base.Rotation()
+	spawn := clientbound.PlayClientboundSpawnEntity{
+		EntityID:   data.VarInt(base.ID),
+		EntityUUID: base.UUID,
+		Type:       data.VarInt(base.Type.IDFor(v.Protocol())),
+		X:          pos.X,
+		Y:          pos.Y,
+		Z:          pos.Z,
+		Pitch:      data.AngleFromDegrees(pitch),
+		Yaw:        data.AngleFromDegrees(yaw),
+		HeadYaw:    data.AngleFromDegrees(base.HeadYaw()),
+		Data:       data.VarInt(base.SpawnData),
+		VelocityX:  encodeVelocity(vel.X),
+		VelocityY:  encodeVelocity(vel.Y),
+		VelocityZ:  encodeVelocity(vel.Z),
+	}
+	if listed, ok := e.(PlayerListed); ok {
+		info, err := listed.PlayerInfo()
+		if err != nil {
+			log.Printf("Error encoding player info of entity %d: %v", base.ID, err)
+			return sends
+		}
+		sends = append(sends, pendingSend{viewer: v, packet: info})
+	}
+	encoded, err := messages.EncodeFor(v.Protocol(), &spawn)
+	if err != nil {
+		log.Printf("Error encoding spawn of entity %d: %v", base.ID, err)
+		return sends
+	}
+	sends = append(sends, pendingSend{viewer: v, packet: encoded})
+
+	metadata := base.Metadata.All()
+	if len(metadata.Entries) == 0 {
+		return sends
+	}
+	encoded, err = messages.Encode(&clientbound.PlayClientboundSetEntityMetadata{
+		EntityID: data.VarInt(base.ID),
+		Metadata: metadata,
+	})
+	if err != nil {
+		log.Printf("Error encoding metadata of entity %d: %v", base.ID, err)
+		return sends
+	}
+	return append(sends, pendingSend{viewer: v, packet: encoded})
+}
+
+func (t *Tracker) appendRemove(sends []pendingSend, v Viewer, ids []int32) []pendingSend {
+	res := clientbound.PlayClientboundRemoveEntities{
+		EntityIDs: make([]data.VarInt, len(ids)),
+	}
+	for i, id := range ids {
+		res.EntityIDs[i] = data.VarInt(id)
+	}
+	encoded, err := messages.Encode(&res)
+	if err != nil {
+		log.Printf("Error encoding entity removal: %v", err)
+		return sends
+	}
+	return append(sends, pendingSend{viewer: v, packet: encoded})
+}
@@ -0,0 +1,67 @@
+package entity
+
+import "github.com/brenfwd/gocraft/constants"
+
+// An entity type from the minecraft:entity_type registry.
+type Type struct {
+	// Registry ID in protocol 767 (1.21), see IDFor
+	ID   int32
+	Name string
+	// Distance in chunks within which players are sent this entity, before
+	// being capped by the view distance. Same values as vanilla.
+	TrackingRange int
+}
+
+var (
+	TypeArmorStand    = &Type{ID: 3, Name: "minecraft:armor_stand", TrackingRange: 10}
+	TypeArrow         = &Type{ID: 4, Name: "minecraft:arrow", TrackingRange: 4}
+	TypeBlockDisplay  = &Type{ID: 9, Name: "minecraft:block_display", TrackingRange: 10}
+	TypeChicken       = &Type{ID: 19, Name: "minecraft:chicken", TrackingRange: 10}
+	TypeCow           = &Type{ID: 22, Name: "minecraft:cow", TrackingRange: 10}
+	TypeCreeper       = &Type{ID: 23, Name: "minecraft:creeper", TrackingRange: 8}
+	TypeExperienceOrb = &Type{ID: 38, Name: "minecraft:experience_orb", TrackingRange: 6}
+	TypeFallingBlock  = &Type{ID: 40, Name: "minecraft:falling_block", TrackingRange: 10}
+	TypeItem          = &Type{ID: 58, Name: "minecraft:item", TrackingRange: 6}
+	TypeItemDisplay   = &Type{ID: 59, Name: "minecraft:item_display", TrackingRange: 10}
+	TypePig           = &Type{ID: 77, Name: "minecraft:pig", TrackingRange: 10}
+	TypeSheep         = &Type{ID: 87, Name: "minecraft:sheep", TrackingRange: 10}
+	TypeSkeleton      = &Type{ID: 91, Name: "minecraft:skeleton", TrackingRange: 8}
+	TypeTextDisplay   = &Type{ID: 105, Name: "minecraft:text_display", TrackingRange: 10}
+	TypeZombie        = &Type{ID: 124, Name: "minecraft:zombie", TrackingRange: 8}
+	TypePlayer        = &Type{ID: 128, Name: "minecraft:player", TrackingRange: 32}
+)
+
+// The registry is sorted by name (apart from the player and fishing bobber at
+// the end), so the types added in 1.20.3 and 1.20.5 shifted every type after
+// them up by one. These are their 1.21 IDs.
+var (
+	typesAddedIn1_20_3 = []int32{
+		12,  // breeze
+		117, // wind_charge
+	}
+	typesAddedIn1_20_5 = []int32{
+		2,  // armadillo
+		11, // bogged
+		13, // breeze_wind_charge
+		61, // ominous_item_spawner
+	}
+)
+
+// Returns the registry ID of the type in the given protocol version.
+func (t *Type) IDFor(protocol constants.ProtocolVersion) int32 {
+	id := t.ID
+	shift := func(added []int32) {
+		for _, addedID := range added {
+			if addedID < t.ID {
+				id--
+			}
+		}
+	}
+	if protocol < constants.Protocol1_20_5 {
+		shift(typesAddedIn1_20_5)
+	}
+	if protocol < constants.Protocol1_20_3 {
+		shift(typesAddedIn1_20_3)
+	}
+	return id
+}
@@ -0,0 +1,420 @@
+package world
+
+import (
+	"fmt"
+	"log"
+	"net/netip"
+	"slices"
+	"sync"
+
+	"github.com/brenfwd/gocraft/constants"
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/forwarding"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/world/entity"
+	"github.com/google/uuid"
+)
+
+// Something packets can be queued on, i.e. a client connection.
+type PacketSender interface {
+	SendPacket(*network.Packet)
+	// Sends the disconnect packet with the given reason, then closes the
+	// connection.
+	Disconnect(*data.Chat)
+	ProtocolVersion() constants.ProtocolVersion
+}
+
+type GameMode byte
+
+const (
+	GameModeSurvival GameMode = iota
+	GameModeCreative
+	GameModeAdventure
+	GameModeSpectator
+)
+
+// Where players' permissions and operator levels come from, e.g. the
+// permissions file and the ops list.
+type PermissionSource interface {
+	Permission(id uuid.UUID, node string) (value bool, ok bool)
+	OpLevel(id uuid.UUID) int
+}
+
+// Survival state kept across sessions. Clients aren't sent it yet.
+type Stats struct {
+	Health         float32
+	FoodLevel      int32
+	FoodSaturation float32
+	FoodExhaustion float32
+	XPLevel        int32
+	// Progress towards the next level, from 0 to 1
+	XPProgress float32
+	XPTotal    int32
+}
+
+// The stats of a new player, like vanilla's.
+var DefaultStats = Stats{Health: 20, FoodLevel: 20, FoodSaturation: 5}
+
+// A stack of items in a player's inventory. Slots are numbered like vanilla's
+// player data: 0-8 for the hotbar, 9-35 for the rest of the inventory,
+// 100-103 for the armor from feet to head and -106 for the offhand.
+type InventoryItem struct {
+	Slot  int8
+	ID    string
+	Count int32
+	// Data components as they were loaded, or nil
+	Components *data.NBTValue
+}
+
+type Player struct {
+	*entity.Base
+	Username string
+	World    *World
+	GameMode GameMode
+	// The client's IP, for IP bans
+	Address netip.Addr
+	// Nil grants only what needs no permission
+	Permissions PermissionSource
+	// Profile properties (i.e. the skin) other players are sent
+	Properties []forwarding.Property
+	conn       PacketSender
+
+	// Teleport IDs handed out so far, and whether the client still has to
+	// confirm the latest one. Movement is ignored while a teleport is pending.
+	// Also guards the stats and inventory.
+	mu               sync.Mutex
+	lastTeleportID   int32
+	awaitingTeleport bool
+	stats            Stats
+	inventory        []InventoryItem
+	// Set once the player logged in again elsewhere and this session was
+	// saved for the new one. It must not be saved again after that.
+	replaced bool
+}
+
+func NewPlayer(w *World, conn PacketSender, id uuid.UUID, username string) *Player {
+	return &Player{
+		Base:     entity.NewBase(entity.TypePlayer, id, w.Spawn),
+		Username: username,
+		World:    w,
+		GameMode: GameModeCreative,
+		conn:     conn,
+		stats:    DefaultStats,
+	}
+}
+
+func (p *Player) SendPacket(packet *network.Packet) {
+	p.conn.SendPacket(packet)
+}
+
+// Protocol version of the player's client.
+func (p *Player) Protocol() constants.ProtocolVersion {
+	return p.conn.ProtocolVersion()
+}
+
+func (p *Player) Name() string {
+	return p.Username
+}
+
+func (p *Player) Permission(node string) (bool, bool) {
+	if p.Permissions == nil {
+		return false, false
+	}
+	return p.Permissions.Permission(p.UUID, node)
+}
+
+func (p *Player) OpLevel() int {
+	if p.Permissions == nil {
+		return 0
+	}
+	return p.Permissions.OpLevel(p.UUID)
+}
+
+// Marks the session as replaced by a new login of the same player.
+func (p *Player) SetReplaced() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.replaced = true
+}
+
+func (p *Player) Replaced() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.replaced
+}
+
+func (p *Player) Stats() Stats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.stats
+}
+
+func (p *Player) SetStats(stats Stats) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.stats = stats
+}
+
+// Returns a copy of the player's items.
+func (p *Player) Inventory() []InventoryItem {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return slices.Clone(p.inventory)
+}
+
+func (p *Player) SetInventory(items []InventoryItem) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.inventory = slices.Clone(items)
+}
+
+// Player Info Update adding the given players to a client's player list.
+// Clients only show players that are in their list.
+func playerInfoPacket(players ...*Player) (network.Packet, error) {
+	res := clientbound.PlayClientboundPlayerInfoUpdate{Actions: clientbound.PlayerInfoAdd}
+	for _, p := range players {
+		entry := clientbound.PlayClientboundPlayerInfoUpdate_Player{
+			UUID:       p.UUID,
+			Name:       p.Username,
+			Properties: []clientbound.PlayClientboundPlayerInfoUpdate_Player_Property{},
+			GameMode:   data.VarInt(p.GameMode),
+			Listed:     true,
+		}
+		for _, property := range p.Properties {
+			entry.Properties = append(entry.Properties, clientbound.PlayClientboundPlayerInfoUpdate_Player_Property(property))
+		}
+		res.Players = append(res.Players, entry)
+	}
+	return messages.Encode(&res)
+}
+
+// Implements entity.PlayerListed, so that viewers have the player in their
+// list before it is spawned for them.
+func (p *Player) PlayerInfo() (network.Packet, error) {
+	return playerInfoPacket(p)
+}
+
+// Shows a message in the player's chat.
+func (p *Player) SendMessage(msg *data.Chat) {
+	encoded, err := messages.Encode(&clientbound.PlayClientboundSystemChatMessage{
+		ContentJSON: *msg,
+		Content:     msg.ToNBT(nil),
+	})
+	if err != nil {
+		log.Printf("Not sending message to %s: %v", p.Username, err)
+		return
+	}
+	p.SendPacket(&encoded)
+}
+
+// Disconnects the player with the given reason.
+func (p *Player) Kick(reason *data.Chat) {
+	p.conn.Disconnect(reason)
+}
+
+// Moves the player to an absolute position, both for the player (Synchronize
+// Player Position) and everyone else (Teleport Entity). The client has to
+// confirm the teleport before its movement is accepted again.
+func (p *Player) Teleport(pos data.Vec3, yaw, pitch float32) error {
+	p.SetPosition(pos)
+	p.SetRotation(yaw, pitch)
+
+	p.mu.Lock()
+	p.lastTeleportID++
+	p.awaitingTeleport = true
+	teleportID := p.lastTeleportID
+	p.mu.Unlock()
+
+	res := clientbound.PlayClientboundSynchronizePlayerPosition{
+		X:          pos.X,
+		Y:          pos.Y,
+		Z:          pos.Z,
+		Yaw:        yaw,
+		Pitch:      pitch,
+		Flags:      0,
+		TeleportID: data.VarInt(teleportID),
+	}
+	encoded, err := messages.Encode(&res)
+	if err != nil {
+		return err
+	}
+	p.SendPacket(&encoded)
+
+	if err := p.broadcastTeleport(pos, yaw, pitch, p.OnGround()); err != nil {
+		return err
+	}
+	p.World.Tracker.Update(p)
+	return nil
+}
+
+// Re-sends the authoritative position, e.g. after rejecting a move.
+func (p *Player) Resync() error {
+	yaw, pitch := p.Rotation()
+	return p.Teleport(p.Position(), yaw, pitch)
+}
+
+func (p *Player) ConfirmTeleport(id int32) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if id > p.lastTeleportID || id <= 0 {
+		return fmt.Errorf("player %s confirmed teleport %d which was never sent", p.Username, id)
+	}
+	if id == p.lastTeleportID {
+		p.awaitingTeleport = false
+	}
+	return nil
+}
+
+func (p *Player) isAwaitingTeleport() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.awaitingTeleport
+}
+
+// A movement update from the client. Fields that are not part of the packet
+// keep their current value.
+type Movement struct {
+	Position    data.Vec3
+	Yaw         float32
+	Pitch       float32
+	OnGround    bool
+	HasPosition bool
+	HasRotation bool
+}
+
+// Applies a movement update from the client and broadcasts it to the players
+// tracking this one. Moves into unloaded chunks are rejected by teleporting the
+// player back to its last position.
+func (p *Player) Move(m Movement) error {
+	if m.HasPosition && !m.Position.IsFinite() {
+		return fmt.Errorf("player %s sent invalid position %v", p.Username, m.Position)
+	}
+
+	if p.isAwaitingTeleport() {
+		return nil
+	}
+
+	prev := p.Position()
+	if !m.HasPosition {
+		m.Position = prev
+	}
+	if !m.HasRotation {
+		m.Yaw, m.Pitch = p.Rotation()
+	}
+
+	if m.HasPosition && !p.World.IsChunkLoaded(ChunkPosAt(m.Position)) {
+		log.Printf("Player %s moved into unloaded chunk %v, resyncing", p.Username, ChunkPosAt(m.Position))
+		return p.Resync()
+	}
+
+	p.SetPosition(m.Position)
+	p.SetRotation(m.Yaw, m.Pitch)
+	p.SetOnGround(m.OnGround)
+
+	// Viewers that already track the player get the relative move first, then
+	// the tracker spawns the player at its new position for new viewers.
+	if err := p.broadcastMove(prev, m); err != nil {
+		return err
+	}
+	if m.HasPosition {
+		p.World.Tracker.Update(p)
+	}
+	return nil
+}
+
+func (p *Player) broadcastMove(prev data.Vec3, m Movement) error {
+	entityID := data.VarInt(p.ID)
+	yaw := data.AngleFromDegrees(m.Yaw)
+	pitch := data.AngleFromDegrees(m.Pitch)
+
+	var encoded network.Packet
+	var err error
+	switch {
+	case m.HasPosition:
+		dx, okX := EncodeDelta(prev.X, m.Position.X)
+		dy, okY := EncodeDelta(prev.Y, m.Position.Y)
+		dz, okZ := EncodeDelta(prev.Z, m.Position.Z)
+		if !okX || !okY || !okZ {
+			return p.broadcastTeleport(m.Position, m.Yaw, m.Pitch, m.OnGround)
+		}
+		if m.HasRotation {
+			encoded, err = messages.Encode(&clientbound.PlayClientboundUpdateEntityPositionAndRotation{
+				EntityID: entityID, DeltaX: dx, DeltaY: dy, DeltaZ: dz, Yaw: yaw, Pitch: pitch, OnGround: m.OnGround,
+			})
+		} else {
+			encoded, err = messages.Encode(&clientbound.PlayClientboundUpdateEntityPosition{
+				EntityID: entityID, DeltaX: dx, DeltaY: dy, DeltaZ: dz, OnGround: m.OnGround,
+			})
+		}
+	case m.HasRotation:
+		encoded, err = messages.Encode(&clientbound.PlayClientboundUpdateEntityRotation{
+			EntityID: entityID, Yaw: yaw, Pitch: pitch, OnGround: m.OnGround,
+		})
+	default:
+		// On Ground only; vanilla sends nothing for this either.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	p.World.Tracker.Broadcast(p, &encoded)
+
+	if m.HasRotation {
+		return p.broadcastHeadRotation(yaw)
+	}
+	return nil
+}
+
+func (p *Player) broadcastTeleport(pos data.Vec3, yaw, pitch float32, onGround bool) error {
+	res := clientbound.PlayClientboundTeleportEntity{
+		EntityID: data.VarInt(p.ID),
+		X:        pos.X,
+		Y:        pos.Y,
+		Z:        pos.Z,
+		Yaw:      data.AngleFromDegrees(yaw),
+		Pitch:    data.AngleFromDegrees(pitch),
+		OnGround: onGround,
+	}
+	encoded, err := messages.Encode(&res)
+	if err != nil {
+		return err
+	}
+	p.World.Tracker.Broadcast(p, &encoded)
+	return p.broadcastHeadRotation(res.Yaw)
+}
+
+func (p *Player) broadcastHeadRotation(yaw data.Angle) error {
+	res := clientbound.PlayClientboundSetHeadRotation{
+		EntityID: data.VarInt(p.ID),
+		HeadYaw:  yaw,
+	}
+	encoded, err := messages.Encode(&res)
+	if err != nil {
+		return err
+	}
+	p.World.Tracker.Broadcast(p, &encoded)
+	return nil
+}
+
+const (
+	// Height of a standing player's eyes above their feet
+	eyeHeight = 1.62
+	// Furthest a player may interact with blocks, from their eyes to the
+	// block's center. Vanilla allows 6 blocks, whatever the game mode.
+	maxReach = 6.0
+)
+
+// Whether the player may break or place the block at pos: it is within reach
+// of their eyes and in a loaded chunk.
+func (p *Player) CanReach(pos data.Position) bool {
+	center := data.Vec3{X: float64(pos.X) + 0.5, Y: float64(pos.Y) + 0.5, Z: float64(pos.Z) + 0.5}
+	if !p.World.IsChunkLoaded(ChunkPosAt(center)) {
+		return false
+	}
+	eyes := p.Position()
+	eyes.Y += eyeHeight
+	dx, dy, dz := center.X-eyes.X, center.Y-eyes.Y, center.Z-eyes.Z
+	return dx*dx+dy*dy+dz*dz <= maxReach*maxReach
+}
@@ -0,0 +1,33 @@
+package world
+
+import (
+	"math"
+
+	"github.com/brenfwd/gocraft/data"
+)
+
+type ChunkPos struct {
+	X, Z int32
+}
+
+// Position of the chunk containing the given world coordinates.
+func ChunkPosAt(pos data.Vec3) ChunkPos {
+	return ChunkPos{
+		X: int32(math.Floor(pos.X)) >> 4,
+		Z: int32(math.Floor(pos.Z)) >> 4,
+	}
+}
+
+// Encodes the movement from prev to cur as used by the Update Entity Position
+// packets: (cur * 32 - prev * 32) * 128. Returns false if the delta does not fit
+// in a short (i.e. the entity moved 8 blocks or more), in which case a Teleport
+// Entity packet has to be sent instead.
+func EncodeDelta(prev, cur float64) (int16, bool) {
+	// Rounding both ends (rather than the difference) keeps successive deltas
+	// from drifting away from the real position.
+	delta := int64(math.Round(cur*4096)) - int64(math.Round(prev*4096))
+	if delta < math.MinInt16 || delta > math.MaxInt16 {
+		return 0, false
+	}
+	return int16(delta), true
+}
@@ -0,0 +1,160 @@
+package world
+
+import (
+	"log"
+	"sync"
+
+	"github.com/brenfwd/gocraft/data"
+	"github.com/brenfwd/gocraft/network"
+	"github.com/brenfwd/gocraft/network/messages"
+	"github.com/brenfwd/gocraft/network/messages/clientbound"
+	"github.com/brenfwd/gocraft/world/block"
+	"github.com/brenfwd/gocraft/world/entity"
+	"github.com/google/uuid"
+)
+
+const (
+	spawnChunkRadius = 8
+	viewDistance     = 10
+)
+
+type World struct {
+	Spawn   data.Vec3
+	Tracker *entity.Tracker
+
+	mu           sync.RWMutex
+	players      map[int32]*Player
+	loadedChunks map[ChunkPos]bool
+	blocks       map[data.Position]block.State // changes to the flat terrain
+}
+
+func NewWorld() *World {
+	w := &World{
+		Spawn:        data.Vec3{X: 0.5, Y: 64, Z: 0.5},
+		Tracker:      entity.NewTracker(viewDistance),
+		players:      make(map[int32]*Player),
+		loadedChunks: make(map[ChunkPos]bool),
+		blocks:       make(map[data.Position]block.State),
+	}
+
+	// TODO: chunks have no contents yet; this only marks the spawn area as
+	// loaded so players can move around in it.
+	center := ChunkPosAt(w.Spawn)
+	for x := center.X - spawnChunkRadius; x <= center.X+spawnChunkRadius; x++ {
+		for z := center.Z - spawnChunkRadius; z <= center.Z+spawnChunkRadius; z++ {
+			w.LoadChunk(ChunkPos{X: x, Z: z})
+		}
+	}
+	log.Printf("Loaded %d spawn chunks", len(w.loadedChunks))
+
+	return w
+}
+
+func (w *World) ViewDistance() int {
+	return viewDistance
+}
+
+func (w *World) LoadChunk(pos ChunkPos) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.loadedChunks[pos] = true
+}
+
+func (w *World) UnloadChunk(pos ChunkPos) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.loadedChunks, pos)
+}
+
+func (w *World) IsChunkLoaded(pos ChunkPos) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.loadedChunks[pos]
+}
+
+// Adds a player to the world and starts tracking it. The client must already be
+// in the play state since this sends it the entities around it.
+func (w *World) AddPlayer(p *Player) {
+	w.mu.Lock()
+	w.players[p.ID] = p
+	w.mu.Unlock()
+
+	// Every player is in everyone's player list, wherever they are
+	if encoded, err := playerInfoPacket(w.Players()...); err != nil {
+		log.Printf("Error encoding player list for %s: %v", p.Username, err)
+	} else {
+		p.SendPacket(&encoded)
+	}
+	if encoded, err := p.PlayerInfo(); err != nil {
+		log.Printf("Error encoding player info of %s: %v", p.Username, err)
+	} else {
+		w.BroadcastExcept(p, &encoded)
+	}
+
+	w.Tracker.AddViewer(p)
+	w.Tracker.Add(p)
+}
+
+func (w *World) RemovePlayer(p *Player) {
+	w.mu.Lock()
+	delete(w.players, p.ID)
+	w.mu.Unlock()
+
+	w.Tracker.Remove(p)
+
+	// A new session of the same player may already have taken its place
+	if w.PlayerByUUID(p.UUID) != nil {
+		return
+	}
+	encoded, err := messages.Encode(&clientbound.PlayClientboundPlayerInfoRemove{Players: []uuid.UUID{p.UUID}})
+	if err != nil {
+		log.Printf("Error encoding player info removal of %s: %v", p.Username, err)
+		return
+	}
+	w.BroadcastExcept(nil, &encoded)
+}
+
+// Runs once per server tick.
+func (w *World) Tick() {
+	w.Tracker.Tick()
+}
+
+func (w *World) AddEntity(e entity.Entity) {
+	w.Tracker.Add(e)
+}
+
+func (w *World) RemoveEntity(e entity.Entity) {
+	w.Tracker.Remove(e)
+}
+
+// Returns a snapshot of the players currently in the world.
+func (w *World) Players() []*Player {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	players := make([]*Player, 0, len(w.players))
+	for _, p := range w.players {
+		players = append(players, p)
+	}
+	return players
+}
+
+// The player in the world with the given UUID, or nil.
+func (w *World) PlayerByUUID(id uuid.UUID) *Player {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	for _, p := range w.players {
+		if p.UUID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// Sends a packet to every player in the world except `except` (which may be nil).
+func (w *World) BroadcastExcept(except *Player, packet *network.Packet) {
+	for _, p := range w.Players() {
+		if p != except {
+			p.SendPacket(packet)
+		}
+	}
+}